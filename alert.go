@@ -0,0 +1,204 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dell/gounity/api"
+	"github.com/dell/gounity/types"
+)
+
+// AlertSeverity is the severity of an alert or event. Lower values are more severe.
+type AlertSeverity int
+
+// Alert and event severities
+const (
+	AlertSeverityEmergency AlertSeverity = 0
+	AlertSeverityAlert     AlertSeverity = 1
+	AlertSeverityCritical  AlertSeverity = 2
+	AlertSeverityError     AlertSeverity = 3
+	AlertSeverityWarning   AlertSeverity = 4
+	AlertSeverityNotice    AlertSeverity = 5
+	AlertSeverityInfo      AlertSeverity = 6
+	AlertSeverityDebug     AlertSeverity = 7
+	AlertSeverityOK        AlertSeverity = 8
+)
+
+// alertWatchInterval is the delay between two polls of WatchAlerts
+var alertWatchInterval = 30 * time.Second
+
+// AlertFilter holds the optional filters of ListAlerts
+type AlertFilter struct {
+	// MinSeverity returns only the alerts at least as severe as the given severity
+	MinSeverity *AlertSeverity
+	// State returns only the alerts in the given state
+	State *int
+	// IsAcknowledged returns only the acknowledged or unacknowledged alerts
+	IsAcknowledged *bool
+	// Since and Until bound the alert timestamps. A zero value leaves the bound open.
+	Since time.Time
+	Until time.Time
+}
+
+// EventFilter holds the optional filters of ListEvents
+type EventFilter struct {
+	// MinSeverity returns only the events at least as severe as the given severity
+	MinSeverity *AlertSeverity
+	// Since and Until bound the event creation times. A zero value leaves the bound open.
+	Since time.Time
+	Until time.Time
+}
+
+// ListAlerts lists the alerts of the array matching the filter. All the alerts are returned when the filter is nil.
+func (c *UnityClientImpl) ListAlerts(ctx context.Context, filter *AlertFilter) ([]types.Alert, error) {
+	var clauses []string
+	if filter != nil {
+		if filter.MinSeverity != nil {
+			clauses = append(clauses, fmt.Sprintf("severity LE %d", *filter.MinSeverity))
+		}
+		if filter.State != nil {
+			clauses = append(clauses, fmt.Sprintf("state EQ %d", *filter.State))
+		}
+		if filter.IsAcknowledged != nil {
+			clauses = append(clauses, fmt.Sprintf("isAcknowledged EQ %t", *filter.IsAcknowledged))
+		}
+		clauses = append(clauses, timeRangeClauses("timestamp", filter.Since, filter.Until)...)
+	}
+
+	var alerts []types.Alert
+	for page := 1; ; page++ {
+		result := &types.ListAlert{}
+		err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, pagedListURI(api.AlertAction, clauses, AlertFields, page), nil, result)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list alerts: %v", err)
+		}
+		alerts = append(alerts, result.Entries...)
+		if len(result.Entries) == 0 || !hasNextPage(result.Links) {
+			return alerts, nil
+		}
+	}
+}
+
+// AcknowledgeAlert marks an alert as acknowledged
+func (c *UnityClientImpl) AcknowledgeAlert(ctx context.Context, alertID string) error {
+	log := c.log(ctx)
+	if alertID == "" {
+		return errors.New("alert ID shouldn't be empty")
+	}
+	modifyParam := &types.AlertModifyParam{IsAcknowledged: true}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyAlertURI, alertID), modifyParam, nil)
+	if err != nil {
+		return fmt.Errorf("acknowledge alert %s failed: %v", alertID, err)
+	}
+	log.Debugf("Acknowledge alert %s successful", alertID)
+	return nil
+}
+
+// DeleteAlert deletes an alert by its ID
+func (c *UnityClientImpl) DeleteAlert(ctx context.Context, alertID string) error {
+	log := c.log(ctx)
+	if alertID == "" {
+		return errors.New("alert ID shouldn't be empty")
+	}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodDelete, fmt.Sprintf(api.UnityAPIGetResourceURI, api.AlertAction, alertID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("delete alert %s failed: %v", alertID, err)
+	}
+	log.Debugf("Delete alert %s successful", alertID)
+	return nil
+}
+
+// ListEvents lists the events of the array matching the filter. All the events are returned when the filter is nil.
+func (c *UnityClientImpl) ListEvents(ctx context.Context, filter *EventFilter) ([]types.Event, error) {
+	var clauses []string
+	if filter != nil {
+		if filter.MinSeverity != nil {
+			clauses = append(clauses, fmt.Sprintf("severity LE %d", *filter.MinSeverity))
+		}
+		clauses = append(clauses, timeRangeClauses("creationTime", filter.Since, filter.Until)...)
+	}
+
+	var events []types.Event
+	for page := 1; ; page++ {
+		result := &types.ListEvent{}
+		err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, pagedListURI(api.EventAction, clauses, EventFields, page), nil, result)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list events: %v", err)
+		}
+		events = append(events, result.Entries...)
+		if len(result.Entries) == 0 || !hasNextPage(result.Links) {
+			return events, nil
+		}
+	}
+}
+
+// WatchAlerts polls the array for alerts raised at or after since and sends each alert once on the returned channel.
+// Poll failures are logged and retried on the next poll. The channel is closed when the context is done.
+func (c *UnityClientImpl) WatchAlerts(ctx context.Context, since time.Time) <-chan types.Alert {
+	alerts := make(chan types.Alert)
+	go c.watchAlerts(ctx, since, alerts)
+	return alerts
+}
+
+func (c *UnityClientImpl) watchAlerts(ctx context.Context, since time.Time, alerts chan<- types.Alert) {
+	log := c.log(ctx)
+	defer close(alerts)
+
+	// seen holds the timestamp of the alerts already sent, so that alerts sharing the polling boundary are not sent twice
+	seen := make(map[string]time.Time)
+	ticker := time.NewTicker(alertWatchInterval)
+	defer ticker.Stop()
+	for {
+		result, err := c.ListAlerts(ctx, &AlertFilter{Since: since})
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Warnf("WatchAlerts: %v", err)
+		}
+		for _, alert := range result {
+			content := alert.AlertContent
+			if _, ok := seen[content.ID]; ok {
+				continue
+			}
+			select {
+			case alerts <- alert:
+			case <-ctx.Done():
+				return
+			}
+			seen[content.ID] = content.Timestamp
+			if content.Timestamp.After(since) {
+				since = content.Timestamp
+			}
+		}
+		// Only the alerts at the boundary can be returned again by the next poll
+		for id, timestamp := range seen {
+			if timestamp.Before(since) {
+				delete(seen, id)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,175 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/dell/gounity/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func alertEntry(id string, timestamp time.Time) types.Alert {
+	return types.Alert{AlertContent: types.AlertContent{ID: id, Timestamp: timestamp, Severity: int(AlertSeverityError)}}
+}
+
+func TestListAlerts(t *testing.T) {
+	fmt.Println("Begin - List Alerts Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+	ctx := context.Background()
+
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	severity := AlertSeverityWarning
+	acknowledged := false
+	filter := url.QueryEscape(`severity LE 4 and isAcknowledged EQ false and timestamp GE "2025-01-01T00:00:00.000Z"`)
+	firstPage := fmt.Sprintf("/api/types/alert/instances?filter=%s&fields=%s&per_page=1000&page=1", filter, AlertFields)
+	secondPage := fmt.Sprintf("/api/types/alert/instances?filter=%s&fields=%s&per_page=1000&page=2", filter, AlertFields)
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", firstPage, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListAlert")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListAlert)
+		resp.Entries = []types.Alert{alertEntry("alert_1", since)}
+		resp.Links = []types.Link{{Rel: "next", Href: "&page=2"}}
+	}).Once()
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", secondPage, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListAlert")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListAlert)
+		resp.Entries = []types.Alert{alertEntry("alert_2", since.Add(time.Minute))}
+	}).Once()
+	alerts, err := testConf.client.ListAlerts(ctx, &AlertFilter{MinSeverity: &severity, IsAcknowledged: &acknowledged, Since: since})
+	assert.NoError(t, err)
+	assert.Len(t, alerts, 2)
+	assert.Equal(t, "alert_2", alerts[1].AlertContent.ID)
+
+	// No filter
+	allAlerts := fmt.Sprintf("/api/types/alert/instances?fields=%s&per_page=1000&page=1", AlertFields)
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", allAlerts, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListAlert")).Return(nil).Once()
+	alerts, err = testConf.client.ListAlerts(ctx, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, alerts)
+
+	// Negative case
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListAlert")).Return(errors.New("error")).Once()
+	_, err = testConf.client.ListAlerts(ctx, nil)
+	assert.Error(t, err)
+
+	fmt.Println("List Alerts Test Successful")
+}
+
+func TestAcknowledgeAlert(t *testing.T) {
+	fmt.Println("Begin - Acknowledge Alert Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+	ctx := context.Background()
+
+	err := testConf.client.AcknowledgeAlert(ctx, "")
+	assert.Error(t, err)
+
+	mockClient.On("DoWithHeaders", mock.Anything, "POST", "/api/instances/alert/alert_1/action/modify", mock.Anything, &types.AlertModifyParam{IsAcknowledged: true}, mock.Anything).Return(nil).Once()
+	err = testConf.client.AcknowledgeAlert(ctx, "alert_1")
+	assert.NoError(t, err)
+
+	mockClient.On("DoWithHeaders", anyArgs...).Return(errors.New("error")).Once()
+	err = testConf.client.AcknowledgeAlert(ctx, "alert_1")
+	assert.Error(t, err)
+
+	fmt.Println("Acknowledge Alert Test Successful")
+}
+
+func TestDeleteAlert(t *testing.T) {
+	fmt.Println("Begin - Delete Alert Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+	ctx := context.Background()
+
+	err := testConf.client.DeleteAlert(ctx, "")
+	assert.Error(t, err)
+
+	mockClient.On("DoWithHeaders", mock.Anything, "DELETE", "/api/instances/alert/alert_1", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err = testConf.client.DeleteAlert(ctx, "alert_1")
+	assert.NoError(t, err)
+
+	mockClient.On("DoWithHeaders", anyArgs...).Return(errors.New("error")).Once()
+	err = testConf.client.DeleteAlert(ctx, "alert_1")
+	assert.Error(t, err)
+
+	fmt.Println("Delete Alert Test Successful")
+}
+
+func TestListEvents(t *testing.T) {
+	fmt.Println("Begin - List Events Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+	ctx := context.Background()
+
+	until := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	filter := url.QueryEscape(`creationTime LT "2025-01-02T00:00:00.000Z"`)
+	uri := fmt.Sprintf("/api/types/event/instances?filter=%s&fields=%s&per_page=1000&page=1", filter, EventFields)
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", uri, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListEvent")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListEvent)
+		resp.Entries = []types.Event{{EventContent: types.EventContent{ID: "event_1", Message: "User admin logged in"}}}
+	}).Once()
+	events, err := testConf.client.ListEvents(ctx, &EventFilter{Until: until})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "event_1", events[0].EventContent.ID)
+
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListEvent")).Return(errors.New("error")).Once()
+	_, err = testConf.client.ListEvents(ctx, nil)
+	assert.Error(t, err)
+
+	fmt.Println("List Events Test Successful")
+}
+
+func TestWatchAlerts(t *testing.T) {
+	fmt.Println("Begin - Watch Alerts Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+	defer func(interval time.Duration) { alertWatchInterval = interval }(alertWatchInterval)
+	alertWatchInterval = time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	listAlerts := func(alerts ...types.Alert) {
+		mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListAlert")).Return(nil).Run(func(args mock.Arguments) {
+			resp := args.Get(5).(*types.ListAlert)
+			resp.Entries = alerts
+		}).Once()
+	}
+	listAlerts(alertEntry("alert_1", since), alertEntry("alert_2", since.Add(time.Minute)))
+	// Failed polls are retried
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListAlert")).Return(errors.New("error")).Once()
+	// The alert at the polling boundary is returned again
+	listAlerts(alertEntry("alert_2", since.Add(time.Minute)), alertEntry("alert_3", since.Add(2*time.Minute)))
+	listAlerts(alertEntry("alert_3", since.Add(2*time.Minute)), alertEntry("alert_4", since.Add(2*time.Minute)))
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListAlert")).Return(nil)
+
+	var ids []string
+	for alert := range testConf.client.WatchAlerts(ctx, since) {
+		ids = append(ids, alert.AlertContent.ID)
+		if len(ids) == 4 {
+			cancel()
+		}
+	}
+	assert.Equal(t, []string{"alert_1", "alert_2", "alert_3", "alert_4"}, ids)
+
+	fmt.Println("Watch Alerts Test Successful")
+}
@@ -22,7 +22,7 @@ import (
 	"net/http"
 	"net/http/httputil"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/dell/gounity/util"
 )
 
 func isBinOctetBody(h http.Header) bool {
@@ -42,10 +42,17 @@ var (
 )
 
 func logRequest(
-	_ context.Context,
+	ctx context.Context,
+	log util.Logger,
+	r *redactor,
 	req *http.Request,
-	_ func(func(args ...interface{}), string),
 ) {
+	if log == nil {
+		log = util.ContextLogger(ctx, nil)
+	}
+	if r == nil {
+		r = defaultRedactor
+	}
 	w := &bytes.Buffer{}
 
 	fmt.Fprintln(w)
@@ -58,22 +65,30 @@ func logRequest(
 		return
 	}
 
-	err2 := writeIndented(w, buf)
+	err2 := writeIndented(w, r.dump(buf))
 	if err2 != nil {
 		message := fmt.Sprintf("Indentation failed with error: %v", err2)
 		log.Info(message)
 	}
 	fmt.Fprintln(w)
 
-	// Will not be logging request to avoid logging of headers as it is
-	// lf(log.Debug, w.String())
+	// The credentials of the headers and the body are masked. The dumps are logged at info level, as ShowHTTP
+	// enables them whatever the level of the logger.
+	log.Info(w.String())
 }
 
 func logResponse(
-	_ context.Context,
+	ctx context.Context,
+	log util.Logger,
+	r *redactor,
 	res *http.Response,
-	_ func(func(args ...interface{}), string),
 ) {
+	if log == nil {
+		log = util.ContextLogger(ctx, nil)
+	}
+	if r == nil {
+		r = defaultRedactor
+	}
 	w := &bytes.Buffer{}
 
 	fmt.Fprintln(w)
@@ -87,7 +102,7 @@ func logResponse(
 	}
 
 	bw := &bytes.Buffer{}
-	err2 := writeIndented(bw, buf)
+	err2 := writeIndented(bw, r.dump(buf))
 	if err2 != nil {
 		message := fmt.Sprintf("Indentation failed with error: %v", err2)
 		log.Info(message)
@@ -101,7 +116,7 @@ func logResponse(
 		fmt.Fprintln(w, scanner.Text())
 	}
 
-	log.Debug(w.String())
+	log.Info(w.String())
 }
 
 // WriteIndentedN indents all lines n spaces.
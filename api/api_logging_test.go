@@ -66,7 +66,7 @@ func TestLogRequest(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		logRequest(context.TODO(), req, nil)
+		logRequest(context.TODO(), nil, nil, req)
 	})
 
 	// Test case: Request with binary octet stream
@@ -77,7 +77,7 @@ func TestLogRequest(t *testing.T) {
 		}
 		req.Header.Set(HeaderKeyContentType, headerValContentTypeBinaryOctetStream)
 
-		logRequest(context.TODO(), req, nil)
+		logRequest(context.TODO(), nil, nil, req)
 	})
 
 	// Test case: Request with body
@@ -87,7 +87,7 @@ func TestLogRequest(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		logRequest(context.TODO(), req, nil)
+		logRequest(context.TODO(), nil, nil, req)
 	})
 
 	// Test case: Request with body and binary octet stream
@@ -98,7 +98,7 @@ func TestLogRequest(t *testing.T) {
 		}
 		req.Header.Set(HeaderKeyContentType, headerValContentTypeBinaryOctetStream)
 
-		logRequest(context.TODO(), req, nil)
+		logRequest(context.TODO(), nil, nil, req)
 	})
 
 	// // Test case: Request with error in DumpRequest
@@ -117,7 +117,7 @@ func TestLogRequest(t *testing.T) {
 		}
 
 		// Call the logRequest function
-		logRequest(context.TODO(), req, nil)
+		logRequest(context.TODO(), nil, nil, req)
 	})
 
 	// Test case: Request with error in WriteIndented
@@ -142,7 +142,7 @@ func TestLogRequest(t *testing.T) {
 		}
 
 		// Call the logRequest function
-		logRequest(context.TODO(), req, nil)
+		logRequest(context.TODO(), nil, nil, req)
 	})
 }
 
@@ -154,7 +154,7 @@ func TestLogResponse(t *testing.T) {
 				"Content-Type": []string{"application/json"},
 			},
 		}
-		logResponse(context.Background(), res, nil)
+		logResponse(context.Background(), nil, nil, res)
 		// Add assertions to check if the response is logged correctly
 	})
 
@@ -163,7 +163,7 @@ func TestLogResponse(t *testing.T) {
 		res := &http.Response{
 			Header: http.Header{},
 		}
-		logResponse(context.Background(), res, nil)
+		logResponse(context.Background(), nil, nil, res)
 		// Add assertions to check if the response is logged correctly
 	})
 
@@ -174,7 +174,7 @@ func TestLogResponse(t *testing.T) {
 				"Content-Type": []string{"binary/octet-stream"},
 			},
 		}
-		logResponse(context.Background(), res, nil)
+		logResponse(context.Background(), nil, nil, res)
 		// Add assertions to check if the response is logged correctly
 	})
 
@@ -185,7 +185,7 @@ func TestLogResponse(t *testing.T) {
 				"Content-Type": []string{"application/json"},
 			},
 		}
-		logResponse(context.Background(), res, nil)
+		logResponse(context.Background(), nil, nil, res)
 		// Add assertions to check if the indentation error is logged correctly
 	})
 
@@ -206,7 +206,7 @@ func TestLogResponse(t *testing.T) {
 		}
 
 		// Call the logResponse function
-		logResponse(context.TODO(), res, nil)
+		logResponse(context.TODO(), nil, nil, res)
 	})
 
 	// Test case: Response with error in WriteIndented
@@ -232,7 +232,7 @@ func TestLogResponse(t *testing.T) {
 		}
 
 		// Call the logResponse function
-		logResponse(context.TODO(), res, nil)
+		logResponse(context.TODO(), nil, nil, res)
 	})
 }
 
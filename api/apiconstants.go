@@ -55,6 +55,12 @@ const (
 	// UnityAPILoginSessionInfoURI LOGINS resource URIs
 	UnityAPILoginSessionInfoURI = unityAPITypes + "/loginSessionInfo"
 
+	// UnityAPILoginSessionInstancesURI gets the current login session {1}=fields
+	UnityAPILoginSessionInstancesURI = UnityAPILoginSessionInfoURI + "/instances?fields=%s"
+
+	// UnityAPILogoutURI ends the current login session
+	UnityAPILogoutURI = UnityAPILoginSessionInfoURI + "/action/logout"
+
 	// UnityAPIBasicSysInfoURI gets BasicSystemInfo URI
 	UnityAPIBasicSysInfoURI = unityAPITypes + "/basicSystemInfo/instances"
 
@@ -94,12 +100,40 @@ const (
 	UnityListHostInitiatorsURI = unityAPITypes + "/hostInitiator/instances?fields="
 	UnityModifyHostInitiators  = unityRootAPI + "/instances/hostInitiator/%s/action/modify"
 
+	// UnityModifyIscsiSettingsURI Modify iSCSI Settings URI
+	UnityModifyIscsiSettingsURI = unityRootAPI + "/instances/iscsiSettings/%s/action/modify"
+
 	// UnityInstancesFilter does Unity Instance Filter
 	UnityInstancesFilter = UnityAPIInstanceTypeResources + "?filter=%s"
 
+	// UnityInstancesFilterWithPage does Unity Instance Filter {1}=type of resource, {2}=filter, {3}=entries per page, {4}=page
+	UnityInstancesFilterWithPage = UnityInstancesFilter + "&per_page=%d&page=%d"
+
+	// UnityInstancesFilterWithFields does Unity Instance Filter {1}=type of resource, {2}=filter, {3}=fields
+	UnityInstancesFilterWithFields = UnityInstancesFilter + "&fields=%s"
+
+	// UnityInstancesFilterWithFieldsAndPage does Unity Instance Filter with paging {1}=type of resource, {2}=filter, {3}=fields, {4}=page size, {5}=page
+	UnityInstancesFilterWithFieldsAndPage = UnityInstancesFilterWithFields + "&per_page=%d&page=%d"
+
+	// UnityAPIInstanceTypeResourcesWithFieldsAndPage lists the instances of a type with paging {1}=type of resource, {2}=fields, {3}=page size, {4}=page
+	UnityAPIInstanceTypeResourcesWithFieldsAndPage = UnityAPIInstanceTypeResourcesWithFields + "&per_page=%d&page=%d"
+
+	// UnityAPIInstanceTypeCountURI counts the instances of a type {1}=type of resource
+	UnityAPIInstanceTypeCountURI = UnityAPIInstanceTypeResources + "?fields=id&per_page=1&with_entrycount=true"
+
+	// UnityModifyAlertURI Modify Alert URI
+	UnityModifyAlertURI = unityRootAPI + "/instances/alert/%s/action/modify"
+
+	// UnityModifyPoolURI Modify Pool URI
+	UnityModifyPoolURI = unityRootAPI + "/instances/pool/%s/action/modify"
+
+	// UnityModifyHostURI Modify Host URI
+	UnityModifyHostURI = unityRootAPI + "/instances/host/%s/action/modify"
+
 	UnityMetric              = "metric"
 	UnityMetricQueryResult   = "metricQueryResult"
 	UnityMetricRealTimeQuery = "metricRealTimeQuery"
+	UnityMetricValue         = "metricValue"
 
 	// UnitySystemCapacity is used to get capacity metrics for Unity XT
 	UnitySystemCapacity = "systemCapacity"
@@ -113,9 +147,18 @@ const (
 	NfsShareAction          = "nfsShare"
 	StorageResourceAction   = "storageResource"
 	HostAction              = "host"
+	HostLUNAction           = "hostLUN"
 	IPInterface             = "ipInterface"
+	IscsiPortalAction       = "iscsiPortal"
+	FcPortAction            = "fcPort"
+	EthernetPortAction      = "ethernetPort"
 	SnapAction              = "snap"
 	PoolAction              = "pool"
+	AlertAction             = "alert"
+	SystemAction            = "system"
+	FeatureAction           = "feature"
+	SystemLimitAction       = "systemLimit"
+	EventAction             = "event"
 	IOLimitPolicy           = "ioLimitPolicy"
 	LicenseAction           = "license"
 	HostInitiatorPathAction = "hostInitiatorPath"
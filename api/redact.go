@@ -0,0 +1,200 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// RedactedValue replaces the sensitive values in the logs and the HTTP dumps
+const RedactedValue = "<redacted>"
+
+// Redacted by default in the logs and the HTTP dumps
+var (
+	// DefaultRedactedHeaders are the headers whose values are masked
+	DefaultRedactedHeaders = []string{AuthorizationHeader, "Proxy-Authorization", HeaderEMCCSRFToken, "X-Auth-Token"}
+	// DefaultRedactedCookies are the cookies whose values are masked, "*" masks all of them
+	DefaultRedactedCookies = []string{"*"}
+	// DefaultRedactedFields are the JSON fields whose values are masked. A field is masked when its name
+	// contains one of them, case insensitively, e.g. chapSecret and reverseCHAPSecret for "secret".
+	DefaultRedactedFields = []string{"password", "passwd", "secret", "token", "credential", "passphrase", "privateKey"}
+)
+
+// jsonFieldPattern matches a JSON field name and the separator before its value
+var jsonFieldPattern = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"\s*:\s*`)
+
+// redactor masks the sensitive headers, cookies and JSON fields of the logged requests and responses
+type redactor struct {
+	headers    map[string]bool
+	cookies    map[string]bool
+	allCookies bool
+	fields     []string
+}
+
+// newRedactor returns a redactor of the default headers, cookies and fields, and of the given additional ones
+func newRedactor(headers, cookies, fields []string) *redactor {
+	r := &redactor{
+		headers: make(map[string]bool),
+		cookies: make(map[string]bool),
+	}
+	for _, header := range append(append([]string{}, DefaultRedactedHeaders...), headers...) {
+		r.headers[http.CanonicalHeaderKey(header)] = true
+	}
+	for _, cookie := range append(append([]string{}, DefaultRedactedCookies...), cookies...) {
+		if cookie == "*" {
+			r.allCookies = true
+		}
+		r.cookies[cookie] = true
+	}
+	for _, field := range append(append([]string{}, DefaultRedactedFields...), fields...) {
+		if field != "" {
+			r.fields = append(r.fields, strings.ToLower(field))
+		}
+	}
+	return r
+}
+
+var defaultRedactor = newRedactor(nil, nil, nil)
+
+// header returns the value of a header to log
+func (r *redactor) header(name, value string) string {
+	switch name = http.CanonicalHeaderKey(name); name {
+	case "Cookie":
+		return r.cookieList(value)
+	case "Set-Cookie":
+		// Only the first pair of Set-Cookie is a cookie, the others are its attributes
+		cookie, attributes, found := strings.Cut(value, ";")
+		if found {
+			return r.cookieList(cookie) + ";" + attributes
+		}
+		return r.cookieList(cookie)
+	}
+	if r.headers[name] {
+		return RedactedValue
+	}
+	return value
+}
+
+// cookieList masks the values of the cookies of a Cookie header or of the cookie of a Set-Cookie header
+func (r *redactor) cookieList(value string) string {
+	pairs := strings.Split(value, ";")
+	for i, pair := range pairs {
+		name, _, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if found && (r.allCookies || r.cookies[name]) {
+			pair = name + "=" + RedactedValue
+		}
+		pairs[i] = strings.TrimSpace(pair)
+	}
+	return strings.Join(pairs, "; ")
+}
+
+// isSensitiveField tells whether the value of a JSON field must be masked
+func (r *redactor) isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+	for _, field := range r.fields {
+		if strings.Contains(name, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// json masks the values of the sensitive fields of a JSON document, the whole value when it is an object or
+// an array. The document does not need to be valid, so that truncated or chunked bodies are masked too.
+func (r *redactor) json(data []byte) []byte {
+	var redacted []byte
+	last := 0
+	for pos := 0; pos < len(data); {
+		loc := jsonFieldPattern.FindSubmatchIndex(data[pos:])
+		if loc == nil {
+			break
+		}
+		valueStart := pos + loc[1]
+		valueEnd := jsonValueEnd(data, valueStart)
+		if valueEnd == valueStart || !r.isSensitiveField(string(data[pos+loc[2]:pos+loc[3]])) {
+			// The fields of object and array values are matched on their own
+			pos = valueStart
+			continue
+		}
+		redacted = append(redacted, data[last:valueStart]...)
+		redacted = append(redacted, `"`+RedactedValue+`"`...)
+		last, pos = valueEnd, valueEnd
+	}
+	if last == 0 {
+		return data
+	}
+	return append(redacted, data[last:]...)
+}
+
+// jsonValueEnd returns the end of the JSON value starting at start, or the end of the data when it is truncated
+func jsonValueEnd(data []byte, start int) int {
+	if start >= len(data) {
+		return start
+	}
+	depth := 0
+	inString := false
+	for i := start; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case inString:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+				if depth == 0 {
+					return i + 1
+				}
+			}
+		case c == '"':
+			inString = true
+		case c == '{' || c == '[':
+			depth++
+		case c == '}' || c == ']':
+			if depth == 0 {
+				return i
+			}
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		case depth == 0 && (c == ',' || c == ' ' || c == '\t' || c == '\r' || c == '\n'):
+			return i
+		}
+	}
+	return len(data)
+}
+
+// dump masks the headers and the body of an HTTP request or response dump
+func (r *redactor) dump(dump []byte) []byte {
+	head, body, found := bytes.Cut(dump, []byte("\r\n\r\n"))
+	lines := bytes.Split(head, []byte("\r\n"))
+	// The first line is the request or status line
+	for i := 1; i < len(lines); i++ {
+		name, value, ok := bytes.Cut(lines[i], []byte(":"))
+		if !ok {
+			continue
+		}
+		lines[i] = []byte(string(name) + ": " + r.header(string(name), strings.TrimSpace(string(value))))
+	}
+	redacted := bytes.Join(lines, []byte("\r\n"))
+	if found {
+		redacted = append(redacted, "\r\n\r\n"...)
+		redacted = append(redacted, r.json(body)...)
+	}
+	return redacted
+}
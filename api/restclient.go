@@ -17,7 +17,6 @@ package api
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"errors"
@@ -107,6 +106,9 @@ type client struct {
 	http     *http.Client
 	host     string
 	token    string
+	headers  map[string]string
+	logger   util.Logger
+	redactor *redactor
 	showHTTP bool
 	debug    bool
 }
@@ -120,8 +122,81 @@ type ClientOptions struct {
 	Timeout time.Duration
 
 	// ShowHTTP is a flag that indicates whether or not HTTP requests and
-	// responses should be logged to stdout
+	// responses should be logged. They are logged at info level, whatever the level of the logger.
 	ShowHTTP bool
+
+	// Debug logs the failures to decode the responses. It is implied by ShowHTTP for the clients
+	// created by gounity.NewClientWithOptions.
+	Debug bool
+
+	// Headers are added to every request which does not set them, e.g. a custom User-Agent. They override
+	// the default JSON Accept and Content-Type headers.
+	Headers map[string]string
+
+	// RedactHeaders, RedactCookies and RedactFields are masked in the logs and the HTTP dumps, in addition to
+	// DefaultRedactedHeaders, DefaultRedactedCookies and DefaultRedactedFields. The JSON fields are masked when
+	// their name contains one of RedactFields, case insensitively.
+	RedactHeaders []string
+	RedactCookies []string
+	RedactFields  []string
+
+	// Logger logs the requests of the client, unless the context carries a logger set by util.WithLogger.
+	// The shared logrus logger of util.GetLogger is used when nil.
+	Logger util.Logger
+
+	// CACertFile is the path of a PEM bundle of the CAs trusted instead of the system ones.
+	// The bundle is reloaded when the file changes.
+	CACertFile string
+
+	// CACert is a PEM bundle of the CAs trusted instead of the system ones, used when CACertFile is empty.
+	CACert []byte
+
+	// ClientCertFile and ClientKeyFile are the PEM certificate and key presented for mutual TLS.
+	// They are reloaded when the files change.
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// PinnedFingerprints are hex SHA-256 fingerprints of the DER certificates accepted for the array, with or
+	// without colons. A certificate of the chain must match one of them, in addition to the chain verification
+	// unless Insecure is set.
+	PinnedFingerprints []string
+
+	// ServerName overrides the host name used to verify the certificate of the array.
+	ServerName string
+
+	// MinTLSVersion is the minimum TLS version, e.g. tls.VersionTLS13. TLS 1.2 is used when zero.
+	MinTLSVersion uint16
+
+	// ProxyURL is the URL of the HTTP proxy used to reach the array, e.g. http://proxy:3128.
+	ProxyURL string
+
+	// ProxyFromEnvironment uses the proxy of the HTTPS_PROXY and NO_PROXY environment variables
+	// when ProxyURL is empty.
+	ProxyFromEnvironment bool
+
+	// MaxIdleConnsPerHost is the number of idle connections kept open to the array for reuse.
+	// DefaultMaxIdleConnsPerHost is used when zero.
+	MaxIdleConnsPerHost int
+
+	// IdleConnTimeout closes the idle connections after this time. DefaultIdleConnTimeout is used when zero.
+	IdleConnTimeout time.Duration
+
+	// TLSHandshakeTimeout limits the TLS handshake. DefaultTLSHandshakeTimeout is used when zero.
+	TLSHandshakeTimeout time.Duration
+
+	// ResponseHeaderTimeout limits the wait for the response headers once the request is sent.
+	// There is no limit when zero.
+	ResponseHeaderTimeout time.Duration
+
+	// DialTimeout limits the establishment of the TCP connections. DefaultDialTimeout is used when zero.
+	DialTimeout time.Duration
+
+	// KeepAlive is the interval of the TCP keepalive probes. DefaultKeepAlive is used when zero.
+	KeepAlive time.Duration
+
+	// Transport replaces the HTTP transport built from the other options, e.g. to instrument the requests.
+	// The TLS, proxy and connection options are ignored when it is set.
+	Transport http.RoundTripper
 }
 
 // New returns a new API client.
@@ -135,36 +210,28 @@ func New(_ context.Context, host string, opts ClientOptions, debug bool) (Client
 	cookieJar, _ := cookiejar.New(&cookiejar.Options{PublicSuffixList: nil})
 
 	c := &client{
-		http:  &http.Client{},
-		host:  host,
-		debug: debug,
+		http:     &http.Client{},
+		host:     host,
+		debug:    debug || opts.Debug,
+		logger:   opts.Logger,
+		redactor: newRedactor(opts.RedactHeaders, opts.RedactCookies, opts.RedactFields),
+	}
+	c.headers = make(map[string]string, len(opts.Headers)+2)
+	c.headers[HeaderKeyAccept] = HeaderValContentTypeJSON
+	c.headers[HeaderKeyContentType] = HeaderValContentTypeJSON
+	for header, value := range opts.Headers {
+		c.headers[header] = value
 	}
 
 	if opts.Timeout != 0 {
 		c.http.Timeout = opts.Timeout
 	}
 
-	if opts.Insecure { // #nosec G402
-		c.http.Transport = &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-				CipherSuites:       util.GetSecuredCipherSuites(),
-			},
-		}
-	} else {
-		pool, err := systemCertPoolFunc()
-		if err != nil {
-			return nil, errSysCerts
-		}
-		c.http.Transport = &http.Transport{
-			TLSClientConfig: &tls.Config{
-				RootCAs:            pool,
-				InsecureSkipVerify: false,
-				CipherSuites:       util.GetSecuredCipherSuites(),
-				MinVersion:         tls.VersionTLS12,
-			},
-		}
+	transport, err := newTransport(host, opts)
+	if err != nil {
+		return nil, err
 	}
+	c.http.Transport = transport
 	c.http.Jar = cookieJar
 	if opts.ShowHTTP {
 		c.showHTTP = true
@@ -172,6 +239,11 @@ func New(_ context.Context, host string, opts ClientOptions, debug bool) (Client
 	return c, nil
 }
 
+// CloseIdleConnections closes the idle connections kept open by the HTTP client
+func (c *client) CloseIdleConnections() {
+	c.http.CloseIdleConnections()
+}
+
 // Makes a GET call to the Unity REST API Server with the given path & headers
 func (c *client) Get(ctx context.Context, path string, headers map[string]string, resp interface{}) error {
 	return c.DoWithHeaders(ctx, http.MethodGet, path, headers, nil, resp)
@@ -206,7 +278,7 @@ func endsWithSlash(s string) bool {
 }
 
 func (c *client) DoAndGetResponseBody(ctx context.Context, method, uri string, headers map[string]string, body interface{}) (*http.Response, error) {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx).WithFields(map[string]interface{}{util.FieldMethod: method, util.FieldURI: uri})
 	var (
 		err                error
 		req                *http.Request
@@ -287,6 +359,11 @@ func (c *client) DoAndGetResponseBody(ctx context.Context, method, uri string, h
 		}
 		req.Header.Add(header, value)
 	}
+	for header, value := range c.headers {
+		if req.Header.Get(header) == "" {
+			req.Header.Set(header, value)
+		}
+	}
 
 	// set the auth token for POST and DELETE methods only
 	if (method == "POST" || method == "DELETE") && c.token != "" {
@@ -294,7 +371,7 @@ func (c *client) DoAndGetResponseBody(ctx context.Context, method, uri string, h
 	}
 
 	if c.showHTTP {
-		logRequest(ctx, req, c.doLog)
+		logRequest(ctx, log, c.redactor, req)
 	}
 
 	// send the request
@@ -304,18 +381,17 @@ func (c *client) DoAndGetResponseBody(ctx context.Context, method, uri string, h
 	}
 
 	if c.showHTTP {
-		logResponse(ctx, res, c.doLog)
+		logResponse(ctx, log, c.redactor, res)
 	}
 
-	log.Debugf("Response code:%d for url: %s", res.StatusCode, uri)
+	log.WithFields(map[string]interface{}{util.FieldStatusCode: res.StatusCode}).Debugf("Response code:%d for url: %s", res.StatusCode, uri)
 	return res, err
 }
 
 func (c *client) DoWithHeaders(ctx context.Context, method, uri string, headers map[string]string, body, resp interface{}) error {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx).WithFields(map[string]interface{}{util.FieldMethod: method, util.FieldURI: uri})
 	if body != nil {
-		data, _ := json.Marshal(body)
-		strBody := strings.ReplaceAll(string(data), "\"", "")
+		strBody := strings.ReplaceAll(c.redactedBody(body), "\"", "")
 		log.Debugf("Request Body: %s", strBody)
 	}
 	res, err := c.DoAndGetResponseBody(ctx, method, uri, headers, body)
@@ -332,7 +408,7 @@ func (c *client) DoWithHeaders(ctx context.Context, method, uri string, headers
 		dec := json.NewDecoder(res.Body)
 		if resp != nil {
 			if err = dec.Decode(resp); err != nil && err != io.EOF {
-				c.doLog(log.WithError(err).Error, fmt.Sprintf("Unable to decode response into %+v", resp))
+				c.doLog(log.WithError(err).Error, fmt.Sprintf("Unable to decode response into %T", resp))
 				return err
 			}
 		}
@@ -348,8 +424,15 @@ func (c *client) DoWithHeaders(ctx context.Context, method, uri string, headers
 		jsonError.ErrorContent.Message = append(jsonError.ErrorContent.Message, types.ErrorMessage{EnUS: string(res.Status)})
 		return jsonError
 	default:
-		log.Debugf("Invalid Response received Body: %s error: %v", body, err)
-		return c.ParseJSONError(ctx, res)
+		log.Debugf("Invalid Response received Body: %s error: %v", c.redactedBody(body), err)
+		err = c.ParseJSONError(ctx, res)
+		if e, ok := err.(*types.Error); ok {
+			log.WithFields(map[string]interface{}{
+				util.FieldStatusCode: res.StatusCode,
+				util.FieldErrorCode:  e.ErrorContent.ErrorCode,
+			}).Debugf("Error response received for url: %s", uri)
+		}
+		return err
 	}
 	return nil
 }
@@ -363,7 +446,7 @@ func (c *client) GetToken() string {
 }
 
 func (c *client) ParseJSONError(ctx context.Context, r *http.Response) error {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	jsonError := &types.Error{}
 	err := json.NewDecoder(r.Body).Decode(jsonError)
 	if err != nil && err != io.EOF {
@@ -377,6 +460,24 @@ func (c *client) ParseJSONError(ctx context.Context, r *http.Response) error {
 	return jsonError
 }
 
+// redactedBody returns the JSON request body to log, with its sensitive fields masked
+func (c *client) redactedBody(body interface{}) string {
+	if body == nil {
+		return ""
+	}
+	r := c.redactor
+	if r == nil {
+		r = defaultRedactor
+	}
+	data, _ := json.Marshal(body)
+	return string(r.json(data))
+}
+
+// log returns the logger of the context, or else the logger of the client
+func (c *client) log(ctx context.Context) util.Logger {
+	return util.ContextLogger(ctx, c.logger)
+}
+
 func (c *client) doLog(l func(args ...interface{}), msg string) {
 	if c.debug {
 		l(msg)
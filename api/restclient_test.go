@@ -14,16 +14,21 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/dell/gounity/types"
+	"github.com/dell/gounity/util"
+	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -348,3 +353,96 @@ func TestClientDo(t *testing.T) {
 	err := c.Do(context.Background(), http.MethodGet, c.host, nil, nil)
 	assert.Error(t, err)
 }
+
+type sensitiveTestBody struct {
+	Secret string `json:"secret"`
+}
+
+func TestDoWithHeadersSensitiveBody(t *testing.T) {
+	logger := util.GetLogger()
+	hook := logtest.NewLocal(logger)
+	defer hook.Reset()
+	level := logger.GetLevel()
+	logger.SetLevel(logrus.DebugLevel)
+	defer logger.SetLevel(level)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+	c := &client{
+		host: server.URL,
+		http: http.DefaultClient,
+	}
+
+	err := c.DoWithHeaders(context.Background(), http.MethodPost, "api/v1/endpoint", nil, sensitiveTestBody{Secret: "topSecretValue"}, nil)
+	assert.Error(t, err)
+	for _, entry := range hook.AllEntries() {
+		assert.NotContains(t, entry.Message, "topSecretValue")
+	}
+}
+
+func TestClientHeaders(t *testing.T) {
+	var agent, accept string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		agent, accept = r.Header.Get("User-Agent"), r.Header.Get(HeaderKeyAccept)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	headers := map[string]string{"User-Agent": "gounity-test", HeaderKeyAccept: "text/plain"}
+	c, err := New(context.Background(), server.URL, ClientOptions{Headers: headers}, false)
+	require.NoError(t, err)
+	// The options are copied when the client is created
+	headers["User-Agent"] = "changed"
+
+	assert.NoError(t, c.DoWithHeaders(context.Background(), http.MethodGet, "/", nil, nil, nil))
+	assert.Equal(t, "gounity-test", agent)
+	assert.Equal(t, "text/plain", accept)
+
+	// The headers of the request take precedence
+	assert.NoError(t, c.DoWithHeaders(context.Background(), http.MethodGet, "/", map[string]string{HeaderKeyAccept: HeaderValContentTypeJSON}, nil, nil))
+	assert.Equal(t, HeaderValContentTypeJSON, accept)
+}
+
+func TestClientLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"error": {"errorCode": 131149829, "httpStatusCode": 422}}`))
+	}))
+	defer server.Close()
+
+	buf := &bytes.Buffer{}
+	logger := util.NewSlogLogger(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c, err := New(context.Background(), server.URL, ClientOptions{Logger: logger}, false)
+	require.NoError(t, err)
+
+	err = c.DoWithHeaders(util.WithRequestID(context.Background(), "req-1"), http.MethodGet, "/api/types/pool/instances", nil, nil, nil)
+	assert.Error(t, err)
+	assert.Contains(t, buf.String(), `"method":"GET"`)
+	assert.Contains(t, buf.String(), `"uri":"/api/types/pool/instances"`)
+	assert.Contains(t, buf.String(), `"errorCode":131149829`)
+	assert.Contains(t, buf.String(), `"requestID":"req-1"`)
+}
+
+func TestClientShowHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// The HTTP dumps of a client do not depend on the level of its logger
+	buf := &bytes.Buffer{}
+	logger := util.NewSlogLogger(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	c, err := New(context.Background(), server.URL, ClientOptions{Logger: logger, ShowHTTP: true}, false)
+	require.NoError(t, err)
+	assert.NoError(t, c.DoWithHeaders(context.Background(), http.MethodGet, "/api/types/pool/instances", nil, nil, nil))
+	assert.Contains(t, buf.String(), "GOUNITY HTTP REQUEST")
+	assert.Contains(t, buf.String(), "GOUNITY HTTP RESPONSE")
+
+	buf.Reset()
+	c, err = New(context.Background(), server.URL, ClientOptions{Logger: logger}, false)
+	require.NoError(t, err)
+	assert.NoError(t, c.DoWithHeaders(context.Background(), http.MethodGet, "/api/types/pool/instances", nil, nil, nil))
+	assert.Empty(t, buf.String())
+}
@@ -0,0 +1,268 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dell/gounity/util"
+)
+
+var (
+	errNoCACerts           = errors.New("no PEM certificate found in the CA bundle")
+	errClientCertKeyPair   = errors.New("client certificate and key files must be set together")
+	errFingerprintMismatch = errors.New("no certificate of the server matches the pinned fingerprints")
+)
+
+// newTLSConfig builds the TLS configuration of the HTTP transport from the client options.
+// The certificate of the array is verified against the host of the endpoint, or the ServerName option when set.
+func newTLSConfig(host string, opts ClientOptions) (*tls.Config, error) {
+	minVersion := opts.MinTLSVersion
+	if minVersion == 0 {
+		minVersion = tls.VersionTLS12
+	}
+	if minVersion < tls.VersionTLS12 || minVersion > tls.VersionTLS13 {
+		return nil, fmt.Errorf("unsupported minimum TLS version %#x, TLS 1.2 or later is required", minVersion)
+	}
+	if (opts.ClientCertFile == "") != (opts.ClientKeyFile == "") {
+		return nil, errClientCertKeyPair
+	}
+	pins, err := parseFingerprints(opts.PinnedFingerprints)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{
+		ServerName:   opts.ServerName,
+		CipherSuites: util.GetSecuredCipherSuites(),
+		MinVersion:   minVersion,
+	}
+	certs := &tlsCertificates{
+		logger:   util.ContextLogger(context.Background(), opts.Logger),
+		caFile:   opts.CACertFile,
+		certFile: opts.ClientCertFile,
+		keyFile:  opts.ClientKeyFile,
+	}
+	if opts.ClientCertFile != "" {
+		if _, err := certs.clientCertificate(); err != nil {
+			return nil, err
+		}
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return certs.clientCertificate()
+		}
+	}
+
+	customRoots := opts.CACertFile != "" || len(opts.CACert) > 0
+	switch {
+	case opts.Insecure: // #nosec G402
+		config.InsecureSkipVerify = true
+	case customRoots:
+		if opts.CACertFile == "" {
+			if certs.roots, err = parseCACerts(opts.CACert); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := certs.rootCAs(); err != nil {
+			return nil, err
+		}
+		// The chain is verified against the CA bundle in VerifyConnection so that a rotated bundle is used
+		// without recreating the client
+		config.InsecureSkipVerify = true // #nosec G402
+	default:
+		pool, err := systemCertPoolFunc()
+		if err != nil {
+			return nil, errSysCerts
+		}
+		config.RootCAs = pool
+	}
+
+	verifyChain := customRoots && !opts.Insecure
+	serverName := opts.ServerName
+	if serverName == "" {
+		serverName = endpointHostname(host)
+	}
+	if verifyChain || len(pins) > 0 {
+		config.VerifyConnection = func(cs tls.ConnectionState) error {
+			if verifyChain {
+				if err := certs.verifyChain(cs, serverName); err != nil {
+					return err
+				}
+			}
+			return verifyFingerprints(cs, pins)
+		}
+	}
+	return config, nil
+}
+
+// tlsCertificates holds the CA bundle and the client certificate, reloaded when their files change
+type tlsCertificates struct {
+	sync.Mutex
+	logger      util.Logger
+	caFile      string
+	caModTime   time.Time
+	roots       *x509.CertPool
+	certFile    string
+	keyFile     string
+	certModTime time.Time
+	keyModTime  time.Time
+	cert        *tls.Certificate
+}
+
+// rootCAs returns the CA pool, reloading the CA bundle file when it has changed.
+// A bundle that can no longer be read keeps the previous pool in use.
+func (t *tlsCertificates) rootCAs() (*x509.CertPool, error) {
+	t.Lock()
+	defer t.Unlock()
+	if t.caFile == "" {
+		return t.roots, nil
+	}
+	info, err := os.Stat(t.caFile)
+	if err == nil && info.ModTime().Equal(t.caModTime) {
+		return t.roots, nil
+	}
+	if err == nil {
+		var pem []byte
+		if pem, err = os.ReadFile(t.caFile); err == nil {
+			var roots *x509.CertPool
+			if roots, err = parseCACerts(pem); err == nil {
+				if t.roots != nil {
+					t.logger.Infof("Reloaded CA bundle %s", t.caFile)
+				}
+				t.roots, t.caModTime = roots, info.ModTime()
+				return t.roots, nil
+			}
+		}
+	}
+	if t.roots == nil {
+		return nil, fmt.Errorf("unable to load CA bundle %s: %v", t.caFile, err)
+	}
+	t.logger.Warnf("Unable to reload CA bundle %s, using the previous one: %v", t.caFile, err)
+	return t.roots, nil
+}
+
+// clientCertificate returns the client certificate, reloading it when the certificate or key file has changed
+func (t *tlsCertificates) clientCertificate() (*tls.Certificate, error) {
+	t.Lock()
+	defer t.Unlock()
+	certInfo, err := os.Stat(t.certFile)
+	var keyInfo os.FileInfo
+	if err == nil {
+		keyInfo, err = os.Stat(t.keyFile)
+	}
+	if err == nil && certInfo.ModTime().Equal(t.certModTime) && keyInfo.ModTime().Equal(t.keyModTime) {
+		return t.cert, nil
+	}
+	if err == nil {
+		var cert tls.Certificate
+		if cert, err = tls.LoadX509KeyPair(t.certFile, t.keyFile); err == nil {
+			if t.cert != nil {
+				t.logger.Infof("Reloaded client certificate %s", t.certFile)
+			}
+			t.cert, t.certModTime, t.keyModTime = &cert, certInfo.ModTime(), keyInfo.ModTime()
+			return t.cert, nil
+		}
+	}
+	if t.cert == nil {
+		return nil, fmt.Errorf("unable to load client certificate %s: %v", t.certFile, err)
+	}
+	t.logger.Warnf("Unable to reload client certificate %s, using the previous one: %v", t.certFile, err)
+	return t.cert, nil
+}
+
+// verifyChain verifies the certificate chain of the server against the CA bundle, and that the certificate is
+// valid for the server name, a host name or an IP address
+func (t *tlsCertificates) verifyChain(cs tls.ConnectionState, serverName string) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("server presented no certificate")
+	}
+	if serverName == "" {
+		return errors.New("no server name to verify the certificate of the server against")
+	}
+	roots, err := t.rootCAs()
+	if err != nil {
+		return err
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range cs.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		DNSName:       serverName,
+	})
+	return err
+}
+
+// endpointHostname returns the host name or IP address of the endpoint, with or without a scheme and a port
+func endpointHostname(host string) string {
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+	endpoint, err := url.Parse(host)
+	if err != nil {
+		return ""
+	}
+	return endpoint.Hostname()
+}
+
+func parseCACerts(pem []byte) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errNoCACerts
+	}
+	return pool, nil
+}
+
+// parseFingerprints decodes hex SHA-256 fingerprints, with or without colon separators
+func parseFingerprints(fingerprints []string) ([][]byte, error) {
+	pins := make([][]byte, 0, len(fingerprints))
+	for _, fingerprint := range fingerprints {
+		pin, err := hex.DecodeString(strings.ReplaceAll(strings.TrimSpace(fingerprint), ":", ""))
+		if err != nil || len(pin) != sha256.Size {
+			return nil, fmt.Errorf("invalid SHA-256 certificate fingerprint %q", fingerprint)
+		}
+		pins = append(pins, pin)
+	}
+	return pins, nil
+}
+
+// verifyFingerprints checks that a certificate presented by the server matches one of the pinned fingerprints
+func verifyFingerprints(cs tls.ConnectionState, pins [][]byte) error {
+	if len(pins) == 0 {
+		return nil
+	}
+	for _, cert := range cs.PeerCertificates {
+		sum := sha256.Sum256(cert.Raw)
+		for _, pin := range pins {
+			if bytes.Equal(sum[:], pin) {
+				return nil
+			}
+		}
+	}
+	return errFingerprintMismatch
+}
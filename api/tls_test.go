@@ -0,0 +1,227 @@
+// Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCertificate generates a self-signed certificate and returns its PEM certificate and key
+func newTestCertificate(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "gounity"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func serverCertPEM(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+func doTLSRequest(t *testing.T, server *httptest.Server, opts ClientOptions) error {
+	c, err := New(context.Background(), server.URL, opts, false)
+	require.NoError(t, err)
+	res, err := c.DoAndGetResponseBody(context.Background(), http.MethodGet, "/api/types/system/instances", nil, nil)
+	if err == nil {
+		res.Body.Close()
+	}
+	return err
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	certPEM, keyPEM := newTestCertificate(t)
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+
+	config, err := newTLSConfig("https://unity.example.com", ClientOptions{ServerName: "unity.example.com", MinTLSVersion: tls.VersionTLS13})
+	assert.NoError(t, err)
+	assert.Equal(t, "unity.example.com", config.ServerName)
+	assert.Equal(t, uint16(tls.VersionTLS13), config.MinVersion)
+	assert.NotNil(t, config.RootCAs)
+
+	config, err = newTLSConfig("https://unity.example.com", ClientOptions{ClientCertFile: certFile, ClientKeyFile: keyFile})
+	assert.NoError(t, err)
+	assert.NotNil(t, config.GetClientCertificate)
+
+	// Negative cases
+	for _, opts := range []ClientOptions{
+		{MinTLSVersion: tls.VersionTLS11},
+		{ClientCertFile: certFile},
+		{ClientCertFile: filepath.Join(dir, "missing.pem"), ClientKeyFile: keyFile},
+		{CACert: []byte("not a certificate")},
+		{CACertFile: filepath.Join(dir, "missing.pem")},
+		{PinnedFingerprints: []string{"AB:CD"}},
+	} {
+		_, err = newTLSConfig("https://unity.example.com", opts)
+		assert.Error(t, err)
+	}
+}
+
+func TestClientCACert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	otherCA, _ := newTestCertificate(t)
+
+	assert.NoError(t, doTLSRequest(t, server, ClientOptions{CACert: serverCertPEM(server)}))
+	assert.Error(t, doTLSRequest(t, server, ClientOptions{CACert: otherCA}))
+	assert.Error(t, doTLSRequest(t, server, ClientOptions{CACert: serverCertPEM(server), ServerName: "unity.invalid"}))
+
+	// The CA bundle file is reloaded when it changes
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, otherCA, 0o600))
+	c, err := New(context.Background(), server.URL, ClientOptions{CACertFile: caFile}, false)
+	require.NoError(t, err)
+	_, err = c.DoAndGetResponseBody(context.Background(), http.MethodGet, "/", nil, nil)
+	assert.Error(t, err)
+
+	require.NoError(t, os.WriteFile(caFile, serverCertPEM(server), 0o600))
+	modTime := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(caFile, modTime, modTime))
+	res, err := c.DoAndGetResponseBody(context.Background(), http.MethodGet, "/", nil, nil)
+	require.NoError(t, err)
+	res.Body.Close()
+}
+
+// newCASignedServer starts a TLS server with a certificate signed by a new CA for the given SANs, and returns the
+// PEM certificate of the CA
+func newCASignedServer(t *testing.T, dnsNames []string, ips []net.IP) (*httptest.Server, []byte) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "gounity CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "unity"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:     dnsNames,
+		IPAddresses:  ips,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})
+}
+
+func TestClientVerifiesEndpointHost(t *testing.T) {
+	// The endpoint is an IP address the certificate does not list
+	server, caPEM := newCASignedServer(t, []string{"unity.example.com"}, nil)
+	assert.Error(t, doTLSRequest(t, server, ClientOptions{CACert: caPEM}))
+	assert.NoError(t, doTLSRequest(t, server, ClientOptions{CACert: caPEM, ServerName: "unity.example.com"}))
+
+	// IP SANs are verified against the IP address of the endpoint
+	server, caPEM = newCASignedServer(t, nil, []net.IP{net.IPv4(127, 0, 0, 1)})
+	assert.NoError(t, doTLSRequest(t, server, ClientOptions{CACert: caPEM}))
+	server, caPEM = newCASignedServer(t, nil, []net.IP{net.IPv4(10, 0, 0, 1)})
+	assert.Error(t, doTLSRequest(t, server, ClientOptions{CACert: caPEM}))
+}
+
+func TestEndpointHostname(t *testing.T) {
+	assert.Equal(t, "10.0.0.1", endpointHostname("https://10.0.0.1:8443"))
+	assert.Equal(t, "unity.example.com", endpointHostname("unity.example.com"))
+	assert.Equal(t, "fd00::1", endpointHostname("https://[fd00::1]"))
+	assert.Empty(t, endpointHostname("https://%zz"))
+}
+
+func TestClientPinnedFingerprints(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	sum := sha256.Sum256(server.Certificate().Raw)
+	fingerprint := hex.EncodeToString(sum[:])
+
+	// A self-signed array certificate can be trusted by its fingerprint alone
+	assert.NoError(t, doTLSRequest(t, server, ClientOptions{Insecure: true, PinnedFingerprints: []string{fingerprint}}))
+	assert.NoError(t, doTLSRequest(t, server, ClientOptions{CACert: serverCertPEM(server), PinnedFingerprints: []string{fingerprint}}))
+
+	other := sha256.Sum256([]byte("other"))
+	assert.Error(t, doTLSRequest(t, server, ClientOptions{Insecure: true, PinnedFingerprints: []string{hex.EncodeToString(other[:])}}))
+}
+
+func TestClientCertificate(t *testing.T) {
+	var clientCerts int
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientCerts = len(r.TLS.PeerCertificates)
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	dir := t.TempDir()
+	certPEM, keyPEM := newTestCertificate(t)
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+
+	assert.NoError(t, doTLSRequest(t, server, ClientOptions{CACert: serverCertPEM(server), ClientCertFile: certFile, ClientKeyFile: keyFile}))
+	assert.Equal(t, 1, clientCerts)
+	assert.Error(t, doTLSRequest(t, server, ClientOptions{CACert: serverCertPEM(server)}))
+}
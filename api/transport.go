@@ -0,0 +1,86 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Default connection settings of the HTTP transport
+const (
+	DefaultMaxIdleConnsPerHost = 16
+	DefaultIdleConnTimeout     = 90 * time.Second
+	DefaultTLSHandshakeTimeout = 10 * time.Second
+	DefaultDialTimeout         = 30 * time.Second
+	DefaultKeepAlive           = 30 * time.Second
+)
+
+// newTransport returns the caller supplied transport, or builds one from the client options
+func newTransport(host string, opts ClientOptions) (http.RoundTripper, error) {
+	if opts.Transport != nil {
+		return opts.Transport, nil
+	}
+	tlsConfig, err := newTLSConfig(host, opts)
+	if err != nil {
+		return nil, err
+	}
+	proxy, err := proxyFunc(opts)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{
+		Timeout:   durationOrDefault(opts.DialTimeout, DefaultDialTimeout),
+		KeepAlive: durationOrDefault(opts.KeepAlive, DefaultKeepAlive),
+	}
+	maxIdleConnsPerHost := opts.MaxIdleConnsPerHost
+	if maxIdleConnsPerHost == 0 {
+		maxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	}
+	return &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialer.DialContext,
+		TLSClientConfig:       tlsConfig,
+		MaxIdleConnsPerHost:   maxIdleConnsPerHost,
+		IdleConnTimeout:       durationOrDefault(opts.IdleConnTimeout, DefaultIdleConnTimeout),
+		TLSHandshakeTimeout:   durationOrDefault(opts.TLSHandshakeTimeout, DefaultTLSHandshakeTimeout),
+		ResponseHeaderTimeout: opts.ResponseHeaderTimeout,
+	}, nil
+}
+
+// proxyFunc returns the proxy selection of the transport, nil when no proxy is used
+func proxyFunc(opts ClientOptions) (func(*http.Request) (*url.URL, error), error) {
+	if opts.ProxyURL != "" {
+		proxyURL, err := url.Parse(opts.ProxyURL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q", opts.ProxyURL)
+		}
+		return http.ProxyURL(proxyURL), nil
+	}
+	if opts.ProxyFromEnvironment {
+		return http.ProxyFromEnvironment, nil
+	}
+	return nil, nil
+}
+
+func durationOrDefault(d, defaultDuration time.Duration) time.Duration {
+	if d == 0 {
+		return defaultDuration
+	}
+	return d
+}
@@ -0,0 +1,96 @@
+// Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewTransport(t *testing.T) {
+	rt, err := newTransport("https://unity.example.com", ClientOptions{})
+	require.NoError(t, err)
+	transport := rt.(*http.Transport)
+	assert.Nil(t, transport.Proxy)
+	assert.Equal(t, DefaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, DefaultIdleConnTimeout, transport.IdleConnTimeout)
+	assert.Equal(t, DefaultTLSHandshakeTimeout, transport.TLSHandshakeTimeout)
+	assert.Zero(t, transport.ResponseHeaderTimeout)
+
+	rt, err = newTransport("https://unity.example.com", ClientOptions{
+		ProxyFromEnvironment:  true,
+		MaxIdleConnsPerHost:   64,
+		IdleConnTimeout:       time.Minute,
+		TLSHandshakeTimeout:   time.Second,
+		ResponseHeaderTimeout: 2 * time.Minute,
+	})
+	require.NoError(t, err)
+	transport = rt.(*http.Transport)
+	assert.NotNil(t, transport.Proxy)
+	assert.Equal(t, 64, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, time.Minute, transport.IdleConnTimeout)
+	assert.Equal(t, time.Second, transport.TLSHandshakeTimeout)
+	assert.Equal(t, 2*time.Minute, transport.ResponseHeaderTimeout)
+
+	// Negative cases
+	_, err = newTransport("https://unity.example.com", ClientOptions{ProxyURL: "proxy:3128"})
+	assert.Error(t, err)
+	_, err = newTransport("https://unity.example.com", ClientOptions{MinTLSVersion: 1})
+	assert.Error(t, err)
+}
+
+func TestClientProxy(t *testing.T) {
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost = r.URL.Host
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	c, err := New(context.Background(), "http://unity.invalid", ClientOptions{ProxyURL: proxy.URL}, false)
+	require.NoError(t, err)
+	res, err := c.DoAndGetResponseBody(context.Background(), http.MethodGet, "/api/types/system/instances", nil, nil)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, "unity.invalid", proxiedHost)
+}
+
+func TestClientTransport(t *testing.T) {
+	var requests int
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		assert.Equal(t, "/api/types/system/instances", req.URL.Path)
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	})
+
+	// The TLS options are not used with a caller supplied transport
+	c, err := New(context.Background(), "https://unity.invalid", ClientOptions{Transport: transport, MinTLSVersion: 1}, false)
+	require.NoError(t, err)
+	assert.NoError(t, c.DoWithHeaders(context.Background(), http.MethodGet, "/api/types/system/instances", nil, nil, &struct{}{}))
+	assert.Equal(t, 1, requests)
+}
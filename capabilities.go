@@ -0,0 +1,176 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dell/gounity/api"
+	"github.com/dell/gounity/types"
+)
+
+// Capability is a behavior of the REST API which depends on the OE release of the array
+type Capability string
+
+// Capabilities checked by the client
+const (
+	DataReductionCapability        Capability = "data reduction"
+	AdvancedDedupCapability        Capability = "advanced deduplication"
+	NFSShareFromSnapshotCapability Capability = "NFS share on snapshot"
+	// InitiatorIDFilterCapability is the filtering of the host initiators by initiatorId, which older releases get wrong
+	InitiatorIDFilterCapability Capability = "host initiator filter by initiatorId"
+)
+
+// capabilityMinVersions holds the earliest OE release supporting each capability
+var capabilityMinVersions = map[Capability]string{
+	DataReductionCapability:        "4.1",
+	AdvancedDedupCapability:        "4.5",
+	NFSShareFromSnapshotCapability: "4.1",
+	InitiatorIDFilterCapability:    "5.0",
+}
+
+// capabilityFeatures holds the feature which must be enabled on the array for a capability
+var capabilityFeatures = map[Capability]string{
+	AdvancedDedupCapability: string(DataReduction),
+}
+
+// featureStateEnabled is the state of a feature enabled on the array
+const featureStateEnabled = 2
+
+// MinAPIVersion is the earliest REST API version the client is qualified against
+const MinAPIVersion = "4.0"
+
+// Capabilities describes what the array supports. It is detected at every successful Authenticate, so that an
+// upgrade of the array is seen at the next login.
+type Capabilities struct {
+	SoftwareVersion    string
+	APIVersion         string
+	EarliestAPIVersion string
+	// Features holds the IDs of the features enabled on the array, nil when they could not be listed
+	Features map[string]bool
+}
+
+// Supports checks whether the OE release and the features of the array support the capability.
+// Capabilities that could not be detected are assumed to be supported.
+func (caps *Capabilities) Supports(capability Capability) bool {
+	return caps.supportsVersion(capability) && caps.HasFeature(capabilityFeatures[capability])
+}
+
+func (caps *Capabilities) supportsVersion(capability Capability) bool {
+	if caps == nil || caps.SoftwareVersion == "" {
+		return true
+	}
+	minVersion, ok := capabilityMinVersions[capability]
+	return !ok || compareVersions(caps.SoftwareVersion, minVersion) >= 0
+}
+
+// HasFeature checks whether the feature is enabled on the array. Features are assumed to be enabled when they
+// could not be detected.
+func (caps *Capabilities) HasFeature(featureID string) bool {
+	if caps == nil || caps.Features == nil || featureID == "" {
+		return true
+	}
+	return caps.Features[featureID]
+}
+
+// Require returns an error naming the OE release or the feature when the array does not support the capability
+func (caps *Capabilities) Require(capability Capability) error {
+	if !caps.supportsVersion(capability) {
+		return fmt.Errorf("%s is unsupported on OE %s, OE %s or later is required", capability, caps.SoftwareVersion, capabilityMinVersions[capability])
+	}
+	if !caps.Supports(capability) {
+		return fmt.Errorf("%s is unsupported, feature %s is not enabled on the array", capability, capabilityFeatures[capability])
+	}
+	return nil
+}
+
+// Capabilities returns the capabilities of the array, or nil before the first successful Authenticate
+func (c *UnityClientImpl) Capabilities() *Capabilities {
+	return c.capabilities.Load()
+}
+
+// detectCapabilities computes the capabilities of the array from its software version and feature list.
+// It runs under the login lock, so the REST API is called directly rather than through executeWithRetryAuthenticate.
+func (c *UnityClientImpl) detectCapabilities(ctx context.Context) error {
+	log := c.log(ctx)
+	basicSystemInfo, err := c.basicSystemInfo(ctx)
+	if err != nil {
+		return err
+	}
+	basic := basicSystemInfo.Entries[0].Content
+	caps := &Capabilities{
+		SoftwareVersion:    basic.SoftwareVersion,
+		APIVersion:         basic.APIVersion,
+		EarliestAPIVersion: basic.EarliestAPIVersion,
+	}
+	if caps.APIVersion != "" && compareVersions(caps.APIVersion, MinAPIVersion) < 0 {
+		log.Warnf("Array REST API version %s is older than the earliest supported version %s", caps.APIVersion, MinAPIVersion)
+	}
+
+	features, err := c.detectFeatures(ctx)
+	if err != nil {
+		log.Warnf("Unable to list the features of the array, assuming they are enabled: %v", err)
+	} else {
+		caps.Features = make(map[string]bool, len(features))
+		for _, feature := range features {
+			if feature.FeatureContent.State == featureStateEnabled {
+				caps.Features[feature.FeatureContent.ID] = true
+			}
+		}
+	}
+
+	log.Debugf("Array capabilities: OE %s, API version %s, %d features enabled", caps.SoftwareVersion, caps.APIVersion, len(caps.Features))
+	c.capabilities.Store(caps)
+	return nil
+}
+
+// detectFeatures returns the features of the array from the license cache, or lists them into the cache
+func (c *UnityClientImpl) detectFeatures(ctx context.Context) ([]types.Feature, error) {
+	if features, ok := c.cachedFeatures(); ok {
+		return features, nil
+	}
+	features := &types.ListFeature{}
+	err := c.api.DoWithHeaders(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.FeatureAction, FeatureFields), c.requestHeaders(), nil, features)
+	if err != nil {
+		return nil, err
+	}
+	return c.cacheFeatures(features.Entries), nil
+}
+
+// compareVersions compares two dotted version strings numerically, returning -1, 0 or 1.
+// Missing or non numeric components count as zero.
+func compareVersions(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aPart, bPart int
+		if i < len(aParts) {
+			aPart, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bPart, _ = strconv.Atoi(bParts[i])
+		}
+		if aPart != bPart {
+			if aPart < bPart {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
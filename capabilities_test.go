@@ -0,0 +1,119 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/dell/gounity/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCompareVersions(t *testing.T) {
+	assert.Equal(t, 0, compareVersions("5.4", "5.4.0"))
+	assert.Equal(t, 1, compareVersions("5.4.0.0.5.094", "5.4"))
+	assert.Equal(t, -1, compareVersions("4.5.1", "5.0"))
+	assert.Equal(t, 1, compareVersions("10.0", "9.9"))
+}
+
+func TestCapabilities(t *testing.T) {
+	fmt.Println("Begin - Capabilities Test")
+
+	// Undetected capabilities never block a call
+	var unknown *Capabilities
+	assert.True(t, unknown.Supports(InitiatorIDFilterCapability))
+	assert.NoError(t, unknown.Require(InitiatorIDFilterCapability))
+
+	assert.True(t, unknown.HasFeature("SNAP"))
+
+	caps := &Capabilities{SoftwareVersion: "4.4.1.1539309879", Features: map[string]bool{"SNAP": true}}
+	assert.True(t, caps.Supports(DataReductionCapability))
+	assert.False(t, caps.Supports(InitiatorIDFilterCapability))
+	assert.EqualError(t, caps.Require(InitiatorIDFilterCapability), "host initiator filter by initiatorId is unsupported on OE 4.4.1.1539309879, OE 5.0 or later is required")
+	assert.False(t, caps.Supports(AdvancedDedupCapability))
+	assert.True(t, caps.HasFeature("SNAP"))
+	assert.False(t, caps.HasFeature("REPLICATION"))
+
+	// Advanced deduplication also needs the data reduction feature
+	caps = &Capabilities{SoftwareVersion: "5.0.0.0.5.116", Features: map[string]bool{}}
+	assert.EqualError(t, caps.Require(AdvancedDedupCapability), "advanced deduplication is unsupported, feature DATA_REDUCTION is not enabled on the array")
+	caps.Features[string(DataReduction)] = true
+	assert.NoError(t, caps.Require(AdvancedDedupCapability))
+
+	fmt.Println("Capabilities Test Successful")
+}
+
+func TestDetectCapabilitiesAtAuthenticate(t *testing.T) {
+	fmt.Println("Begin - Detect Capabilities Test")
+	mockClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: mockClient, configConnect: &ConfigConnect{}}
+	ctx := context.Background()
+	ttl := licenseCacheTTL
+	licenseCacheTTL = time.Minute
+	defer func() {
+		licenseCacheTTL = ttl
+	}()
+
+	body := `{"entries":[{"content":{"id":"0","model":"Unity 300","name":"array-1","softwareVersion":"%s","apiVersion":"4.0","earliestApiVersion":"4.0"}}]}`
+	mockClient.On("SetToken", mock.Anything).Return()
+	mockClient.On("DoAndGetResponseBody", mock.Anything, "GET", "/api/types/loginSessionInfo", mock.Anything, mock.Anything).Return(
+		&http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(""))}, nil)
+	mockClient.On("DoAndGetResponseBody", mock.Anything, "GET", "/api/types/basicSystemInfo/instances", mock.Anything, mock.Anything).Return(
+		&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(fmt.Sprintf(body, "4.0.1.8404134")))}, nil).Once()
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf("/api/types/feature/instances?fields=%s", FeatureFields), mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListFeature")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListFeature)
+		resp.Entries = []types.Feature{
+			{FeatureContent: types.FeatureContent{ID: "SNAP", State: featureStateEnabled}},
+			{FeatureContent: types.FeatureContent{ID: "REPLICATION", State: 1}},
+		}
+	}).Once()
+	assert.Nil(t, client.Capabilities())
+	err := client.Authenticate(ctx, &ConfigConnect{})
+	assert.NoError(t, err)
+	caps := client.Capabilities()
+	assert.NotNil(t, caps)
+	assert.Equal(t, "4.0.1.8404134", caps.SoftwareVersion)
+	assert.False(t, caps.Supports(InitiatorIDFilterCapability))
+	assert.True(t, caps.HasFeature("SNAP"))
+	assert.False(t, caps.HasFeature("REPLICATION"))
+
+	// The features are shared with ListFeatures
+	features, err := client.ListFeatures(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, features, 2)
+
+	// Calls unsupported on the OE release fail before reaching the array
+	_, err = client.CreateNFSShareFromSnapshot(ctx, "share", "/", "snap_1", ReadOnlyDefaultAccess)
+	assert.ErrorContains(t, err, "unsupported on OE 4.0.1.8404134")
+
+	// Capabilities are detected again at the next login, e.g. after an upgrade
+	mockClient.On("DoAndGetResponseBody", mock.Anything, "GET", "/api/types/basicSystemInfo/instances", mock.Anything, mock.Anything).Return(
+		&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(fmt.Sprintf(body, "5.4.0.0.5.094")))}, nil).Once()
+	err = client.Authenticate(ctx, &ConfigConnect{})
+	assert.NoError(t, err)
+	assert.Equal(t, "5.4.0.0.5.094", client.Capabilities().SoftwareVersion)
+	assert.True(t, client.Capabilities().Supports(InitiatorIDFilterCapability))
+	mockClient.AssertExpectations(t)
+
+	fmt.Println("Detect Capabilities Test Successful")
+}
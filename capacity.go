@@ -0,0 +1,141 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dell/gounity/api"
+	"github.com/dell/gounity/types"
+)
+
+// GetCapacityReport gets the capacity of the array aggregated per pool, per resource type, per tenant and per host
+func (c *UnityClientImpl) GetCapacityReport(ctx context.Context) (*types.CapacityReport, error) {
+	systemCapacity, err := c.GetCapacity(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get system capacity: %v", err)
+	}
+	pools, err := c.ListStoragePools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	volumes, err := c.listAllVolumes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list LUNs: %v", err)
+	}
+	filesystems, err := c.ListFilesystems(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hosts, err := c.ListHosts(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	nasServers, err := c.listNASServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &types.CapacityReport{
+		Tenants: make(map[string]types.CapacityUsage),
+		Hosts:   make(map[string]types.CapacityUsage),
+	}
+	if len(systemCapacity.Entries) > 0 {
+		report.System = systemCapacity.Entries[0].Content
+	}
+
+	poolResources := make(map[string]*types.CapacityUsage, len(pools))
+	for _, pool := range pools {
+		poolResources[pool.StoragePoolContent.ID] = &types.CapacityUsage{}
+	}
+	addToPool := func(poolID string, provisioned, used, allocated, snapshot, saved uint64) {
+		if usage, ok := poolResources[poolID]; ok {
+			usage.Add(provisioned, used, allocated, snapshot, saved)
+		}
+	}
+	addTo := func(usages map[string]types.CapacityUsage, key string, provisioned, used, allocated, snapshot, saved uint64) {
+		usage := usages[key]
+		usage.Add(provisioned, used, allocated, snapshot, saved)
+		usages[key] = usage
+	}
+
+	hostTenants := make(map[string]string, len(hosts))
+	for _, host := range hosts {
+		hostTenants[host.HostContent.ID] = host.HostContent.Tenant.ID
+	}
+	for _, volume := range volumes {
+		v := volume.VolumeContent
+		report.LUNs.Add(v.SizeTotal, v.SizeUsed, v.SizeAllocated, v.SnapsSizeAllocated, v.DataReductionSizeSaved)
+		addToPool(v.Pool.ID, v.SizeTotal, v.SizeUsed, v.SizeAllocated, v.SnapsSizeAllocated, v.DataReductionSizeSaved)
+		tenants := make(map[string]bool)
+		for _, access := range v.HostAccessResponse {
+			hostID := access.HostContent.ID
+			addTo(report.Hosts, hostID, v.SizeTotal, v.SizeUsed, v.SizeAllocated, v.SnapsSizeAllocated, v.DataReductionSizeSaved)
+			// A LUN exposed to several hosts of a tenant counts once towards the tenant
+			if tenantID := hostTenants[hostID]; tenantID != "" && !tenants[tenantID] {
+				tenants[tenantID] = true
+				addTo(report.Tenants, tenantID, v.SizeTotal, v.SizeUsed, v.SizeAllocated, v.SnapsSizeAllocated, v.DataReductionSizeSaved)
+			}
+		}
+	}
+
+	nasServerTenants := make(map[string]string, len(nasServers))
+	for _, nasServer := range nasServers {
+		nasServerTenants[nasServer.NASServerContent.ID] = nasServer.NASServerContent.Tenant.ID
+	}
+	for _, filesystem := range filesystems {
+		f := filesystem.FileContent
+		report.Filesystems.Add(f.SizeTotal, f.SizeUsed, f.SizeAllocated, f.SnapsSizeAllocated, f.DataReductionSizeSaved)
+		addToPool(f.Pool.ID, f.SizeTotal, f.SizeUsed, f.SizeAllocated, f.SnapsSizeAllocated, f.DataReductionSizeSaved)
+		if tenantID := nasServerTenants[f.NASServer.ID]; tenantID != "" {
+			addTo(report.Tenants, tenantID, f.SizeTotal, f.SizeUsed, f.SizeAllocated, f.SnapsSizeAllocated, f.DataReductionSizeSaved)
+		}
+	}
+
+	for _, pool := range pools {
+		p := pool.StoragePoolContent
+		report.Pools = append(report.Pools, types.PoolCapacity{
+			ID:                 p.ID,
+			Name:               p.Name,
+			Total:              p.TotalCapacity,
+			Free:               p.FreeCapacity,
+			Used:               p.UsedCapacity,
+			Subscribed:         p.SubscribedCapacity,
+			Snapshot:           p.SnapSizeUsed,
+			DataReductionSaved: p.DataReductionSizeSaved,
+			DataReductionRatio: p.DataReductionRatio,
+			Resources:          *poolResources[p.ID],
+		})
+	}
+	return report, nil
+}
+
+// listNASServers lists the NAS servers of all the pages
+func (c *UnityClientImpl) listNASServers(ctx context.Context) ([]types.NASServer, error) {
+	var nasServers []types.NASServer
+	for page := 1; ; page++ {
+		result := &types.ListNASServer{}
+		err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, pagedListURI(api.NasServerAction, nil, NasServerListFields, page), nil, result)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list NAS Servers: %v", err)
+		}
+		nasServers = append(nasServers, result.Entries...)
+		if len(result.Entries) == 0 || !hasNextPage(result.Links) {
+			return nasServers, nil
+		}
+	}
+}
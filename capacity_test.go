@@ -0,0 +1,136 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/dell/gounity/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const petabyte = uint64(1) << 50
+
+func mockCapacityReportResponses(mockClient *mocksapi.Client) {
+	respond := func(respType string, fill func(resp interface{})) {
+		mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType(respType)).Return(nil).Run(func(args mock.Arguments) {
+			fill(args.Get(5))
+		}).Once()
+	}
+	respond("*types.SystemCapacityMetricsQueryResult", func(resp interface{}) {
+		resp.(*types.SystemCapacityMetricsQueryResult).Entries = []types.SystemCapacityMetricsResultEntry{
+			{Content: types.SystemCapacityMetricResult{ID: "0", SizeTotal: 8 * petabyte, SizeUsed: 3 * petabyte}},
+		}
+	})
+	respond("*types.ListStoragePools", func(resp interface{}) {
+		resp.(*types.ListStoragePools).Entries = []types.StoragePool{
+			{StoragePoolContent: types.StoragePoolContent{ID: "pool_1", TotalCapacity: 4 * petabyte, SnapSizeUsed: 30, DataReductionRatio: 1.5}},
+			{StoragePoolContent: types.StoragePoolContent{ID: "pool_2"}},
+		}
+	})
+	respond("*types.ListVolumes", func(resp interface{}) {
+		resp.(*types.ListVolumes).Volumes = []types.Volume{
+			{VolumeContent: types.VolumeContent{
+				ResourceID: "sv_1", Pool: types.Pool{ID: "pool_1"},
+				SizeTotal: 2 * petabyte, SizeUsed: 100, SizeAllocated: 80, SnapsSizeAllocated: 10, DataReductionSizeSaved: 40,
+				HostAccessResponse: []types.HostAccessResponse{{HostContent: types.HostContent{ID: "host_1"}}, {HostContent: types.HostContent{ID: "host_2"}}},
+			}},
+		}
+		resp.(*types.ListVolumes).Links = []types.Link{{Rel: "next"}}
+	})
+	// The LUNs of the next page are counted too
+	respond("*types.ListVolumes", func(resp interface{}) {
+		resp.(*types.ListVolumes).Volumes = []types.Volume{
+			{VolumeContent: types.VolumeContent{
+				ResourceID: "sv_2", Pool: types.Pool{ID: "pool_2"},
+				SizeTotal: 1000, SizeUsed: 10, SizeAllocated: 10,
+				HostAccessResponse: []types.HostAccessResponse{{HostContent: types.HostContent{ID: "host_3"}}},
+			}},
+		}
+	})
+	respond("*types.ListFilesystem", func(resp interface{}) {
+		resp.(*types.ListFilesystem).Filesystems = []types.Filesystem{
+			{FileContent: types.FileContent{ID: "fs_1", Pool: types.Pool{ID: "pool_1"}, NASServer: types.Pool{ID: "nas_1"}, SizeTotal: 500, SizeUsed: 50, SizeAllocated: 60, SnapsSizeAllocated: 5}},
+		}
+	})
+	respond("*types.ListHost", func(resp interface{}) {
+		resp.(*types.ListHost).Hosts = []types.Host{
+			{HostContent: types.HostContent{ID: "host_1", Tenant: types.TenantContent{ID: "tenant_1"}}},
+			{HostContent: types.HostContent{ID: "host_2", Tenant: types.TenantContent{ID: "tenant_1"}}},
+			{HostContent: types.HostContent{ID: "host_3"}},
+		}
+	})
+	respond("*types.ListNASServer", func(resp interface{}) {
+		resp.(*types.ListNASServer).Entries = []types.NASServer{
+			{NASServerContent: types.NASServerContent{ID: "nas_1", Tenant: types.TenantContent{ID: "tenant_2"}}},
+		}
+	})
+}
+
+func TestGetCapacityReport(t *testing.T) {
+	fmt.Println("Begin - Get Capacity Report Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+
+	mockCapacityReportResponses(mockClient)
+	report, err := testConf.client.GetCapacityReport(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 8*petabyte, report.System.SizeTotal)
+
+	assert.Len(t, report.Pools, 2)
+	assert.Equal(t, 4*petabyte, report.Pools[0].Total)
+	assert.Equal(t, uint64(30), report.Pools[0].Snapshot)
+	assert.Equal(t, 1.5, report.Pools[0].DataReductionRatio)
+	assert.Equal(t, 2, report.Pools[0].Resources.Count)
+	assert.Equal(t, 2*petabyte+500, report.Pools[0].Resources.Provisioned)
+	assert.Equal(t, uint64(15), report.Pools[0].Resources.Snapshot)
+	assert.Equal(t, 1, report.Pools[1].Resources.Count)
+
+	assert.Equal(t, 2, report.LUNs.Count)
+	assert.Equal(t, 2*petabyte+1000, report.LUNs.Provisioned)
+	assert.Equal(t, uint64(90), report.LUNs.Allocated)
+	assert.InDelta(t, 130.0/90.0, report.LUNs.DataReductionRatio(), 0.0001)
+	assert.Equal(t, 1, report.Filesystems.Count)
+	assert.Equal(t, 1.0, report.Filesystems.DataReductionRatio())
+
+	// sv_1 is exposed to two hosts of tenant_1 and counts once towards it
+	assert.Equal(t, 1, report.Tenants["tenant_1"].Count)
+	assert.Equal(t, 2*petabyte, report.Tenants["tenant_1"].Provisioned)
+	assert.Equal(t, uint64(500), report.Tenants["tenant_2"].Provisioned)
+	assert.Len(t, report.Tenants, 2)
+	assert.Len(t, report.Hosts, 3)
+	assert.Equal(t, uint64(80), report.Hosts["host_2"].Allocated)
+	assert.Equal(t, uint64(1000), report.Hosts["host_3"].Provisioned)
+
+	assert.Equal(t, 1.0, types.CapacityUsage{}.DataReductionRatio())
+
+	// Negative cases: each query failing in turn
+	for i := 0; i < 7; i++ {
+		testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+		mockCapacityReportResponses(mockClient)
+		mockClient.ExpectedCalls[i] = mockClient.ExpectedCalls[i].Return(errors.New("query failed"))
+		mockClient.ExpectedCalls[i].RunFn = nil
+		_, err = testConf.client.GetCapacityReport(ctx)
+		assert.Error(t, err, "query %d", i)
+	}
+
+	fmt.Println("Get Capacity Report Test - Successful")
+}
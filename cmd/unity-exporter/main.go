@@ -0,0 +1,132 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Command unity-exporter serves the metrics of a Unity array on /metrics in the Prometheus text format.
+// The credentials are read from the GOUNITY_USERNAME and GOUNITY_PASSWORD environment variables, or from the
+// files named by GOUNITY_USERNAME_FILE and GOUNITY_PASSWORD_FILE, which are re-read when the session is renewed.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/dell/gounity"
+	"github.com/dell/gounity/api"
+	"github.com/dell/gounity/exporter"
+	"github.com/dell/gounity/util"
+)
+
+func main() {
+	log := util.GetLogger()
+	insecure, _ := strconv.ParseBool(os.Getenv("GOUNITY_INSECURE"))
+	debug, _ := strconv.ParseBool(os.Getenv("GOUNITY_DEBUG"))
+	showHTTP, _ := strconv.ParseBool(os.Getenv("GOUNITY_SHOWHTTP"))
+
+	endpoint := flag.String("endpoint", os.Getenv("GOUNITY_ENDPOINT"), "Unity REST endpoint, e.g. https://10.0.0.1")
+	flag.BoolVar(&insecure, "insecure", insecure, "skip the verification of the array certificate")
+	caCert := flag.String("ca-cert", "", "PEM bundle of the CAs trusted for the array certificate instead of the system ones")
+	clientCert := flag.String("client-cert", "", "PEM client certificate presented for mutual TLS")
+	clientKey := flag.String("client-key", "", "PEM key of the client certificate")
+	serverName := flag.String("tls-server-name", "", "host name used to verify the array certificate")
+	fingerprints := flag.String("tls-fingerprints", "", "comma separated SHA-256 fingerprints of the accepted array certificates")
+	listen := flag.String("listen", ":9870", "address the metrics are served on")
+	interval := flag.Duration("interval", exporter.DefaultCollectionInterval, "capacity and health collection interval")
+	performancePaths := flag.String("performance-metrics", "", "comma separated real time metric paths to export, e.g. sp.*.cpu.summary.busyTicks")
+	performanceInterval := flag.Int("performance-interval", exporter.DefaultPerformanceInterval, "real time metrics interval in seconds")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	opts := api.ClientOptions{
+		Insecure:       insecure,
+		Debug:          debug,
+		ShowHTTP:       showHTTP,
+		CACertFile:     *caCert,
+		ClientCertFile: *clientCert,
+		ClientKeyFile:  *clientKey,
+		ServerName:     *serverName,
+		// The array is reached through the proxy of HTTPS_PROXY, if any
+		ProxyFromEnvironment: true,
+	}
+	for _, fingerprint := range strings.Split(*fingerprints, ",") {
+		if fingerprint = strings.TrimSpace(fingerprint); fingerprint != "" {
+			opts.PinnedFingerprints = append(opts.PinnedFingerprints, fingerprint)
+		}
+	}
+	client, err := gounity.NewClientWithOptions(ctx, *endpoint, opts)
+	if err != nil {
+		log.Fatalf("Unable to create the Unity client: %v", err)
+	}
+	var credentials gounity.CredentialProvider = &gounity.EnvCredentialProvider{}
+	if passwordFile := os.Getenv("GOUNITY_PASSWORD_FILE"); passwordFile != "" {
+		credentials = &gounity.FileCredentialProvider{
+			UsernameFile: os.Getenv("GOUNITY_USERNAME_FILE"),
+			PasswordFile: passwordFile,
+		}
+	}
+	err = client.Authenticate(ctx, &gounity.ConfigConnect{
+		Endpoint:    *endpoint,
+		Credentials: credentials,
+		Insecure:    insecure,
+	})
+	if err != nil {
+		log.Fatalf("Unable to authenticate with the Unity array: %v", err)
+	}
+	defer func() {
+		if err := client.Close(); err != nil {
+			log.Warnf("Unable to log out of the Unity array: %v", err)
+		}
+	}()
+	if err := client.StartKeepalive(ctx, gounity.KeepaliveOptions{}); err != nil {
+		log.Warnf("Unable to start the Unity session keepalive: %v", err)
+	}
+
+	config := exporter.Config{
+		CollectionInterval:  *interval,
+		PerformanceInterval: *performanceInterval,
+	}
+	for _, path := range strings.Split(*performancePaths, ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			config.PerformancePaths = append(config.PerformancePaths, path)
+		}
+	}
+	e, err := exporter.New(client, config)
+	if err != nil {
+		log.Fatalf("Unable to create the exporter: %v", err)
+	}
+	go e.Run(ctx)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", e)
+	server := &http.Server{Addr: *listen, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	log.Infof("Serving Unity metrics on %s/metrics", *listen)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("Unable to serve the metrics: %v", err)
+	}
+}
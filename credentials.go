@@ -0,0 +1,137 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Default environment variables read by EnvCredentialProvider
+const (
+	DefaultUsernameEnv = "GOUNITY_USERNAME"
+	DefaultPasswordEnv = "GOUNITY_PASSWORD"
+)
+
+// Credentials holds the username and password used to log in to the array
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// CredentialProvider supplies the credentials of the array. It is called at every authentication,
+// including the re-authentication of expired sessions, so that rotated credentials are picked up.
+type CredentialProvider interface {
+	Credentials(ctx context.Context) (*Credentials, error)
+}
+
+// StaticCredentialProvider provides fixed credentials
+type StaticCredentialProvider struct {
+	Username string
+	Password string
+}
+
+// Credentials returns the fixed credentials
+func (p *StaticCredentialProvider) Credentials(_ context.Context) (*Credentials, error) {
+	return &Credentials{Username: p.Username, Password: p.Password}, nil
+}
+
+// EnvCredentialProvider reads the credentials from environment variables.
+// GOUNITY_USERNAME and GOUNITY_PASSWORD are used when the variable names are empty.
+type EnvCredentialProvider struct {
+	UsernameEnv string
+	PasswordEnv string
+}
+
+// Credentials reads the credentials from the environment
+func (p *EnvCredentialProvider) Credentials(_ context.Context) (*Credentials, error) {
+	usernameEnv, passwordEnv := p.UsernameEnv, p.PasswordEnv
+	if usernameEnv == "" {
+		usernameEnv = DefaultUsernameEnv
+	}
+	if passwordEnv == "" {
+		passwordEnv = DefaultPasswordEnv
+	}
+	username := os.Getenv(usernameEnv)
+	if username == "" {
+		return nil, fmt.Errorf("environment variable %s is not set", usernameEnv)
+	}
+	password := os.Getenv(passwordEnv)
+	if password == "" {
+		return nil, fmt.Errorf("environment variable %s is not set", passwordEnv)
+	}
+	return &Credentials{Username: username, Password: password}, nil
+}
+
+// FileCredentialProvider reads the credentials from files, such as the keys of a mounted Kubernetes secret.
+// The files are read at every authentication so that rotated secrets are used without a restart.
+type FileCredentialProvider struct {
+	UsernameFile string
+	PasswordFile string
+}
+
+// Credentials reads the credentials from the files. A trailing line break is ignored.
+func (p *FileCredentialProvider) Credentials(_ context.Context) (*Credentials, error) {
+	username, err := readCredentialFile(p.UsernameFile)
+	if err != nil {
+		return nil, err
+	}
+	password, err := readCredentialFile(p.PasswordFile)
+	if err != nil {
+		return nil, err
+	}
+	return &Credentials{Username: username, Password: password}, nil
+}
+
+func readCredentialFile(path string) (string, error) {
+	if path == "" {
+		return "", errors.New("credential file path shouldn't be empty")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read credential file: %v", err)
+	}
+	value := strings.TrimRight(string(data), "\r\n")
+	if value == "" {
+		return "", fmt.Errorf("credential file %s is empty", path)
+	}
+	return value, nil
+}
+
+// CredentialProviderFunc adapts a function to a CredentialProvider, e.g. to fetch the credentials from a vault
+type CredentialProviderFunc func(ctx context.Context) (*Credentials, error)
+
+// Credentials calls the function
+func (f CredentialProviderFunc) Credentials(ctx context.Context) (*Credentials, error) {
+	return f(ctx)
+}
+
+// credentials gets the credentials from the provider of the configuration, or from its Username and Password
+func (configConnect *ConfigConnect) credentials(ctx context.Context) (*Credentials, error) {
+	if configConnect.Credentials == nil {
+		return &Credentials{Username: configConnect.Username, Password: configConnect.Password}, nil
+	}
+	credentials, err := configConnect.Credentials.Credentials(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the credentials: %v", err)
+	}
+	if credentials == nil {
+		return nil, errors.New("unable to get the credentials: none provided")
+	}
+	return credentials, nil
+}
@@ -0,0 +1,127 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dell/gounity/api"
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/dell/gounity/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEnvCredentialProvider(t *testing.T) {
+	fmt.Println("Begin - Env Credential Provider Test")
+	ctx := context.Background()
+
+	t.Setenv(DefaultUsernameEnv, "admin")
+	t.Setenv(DefaultPasswordEnv, "password")
+	credentials, err := (&EnvCredentialProvider{}).Credentials(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, &Credentials{Username: "admin", Password: "password"}, credentials)
+
+	t.Setenv("UNITY_PASSWORD", "")
+	_, err = (&EnvCredentialProvider{PasswordEnv: "UNITY_PASSWORD"}).Credentials(ctx)
+	assert.Error(t, err)
+
+	fmt.Println("Env Credential Provider Test Successful")
+}
+
+func TestFileCredentialProvider(t *testing.T) {
+	fmt.Println("Begin - File Credential Provider Test")
+	ctx := context.Background()
+	dir := t.TempDir()
+	provider := &FileCredentialProvider{UsernameFile: filepath.Join(dir, "username"), PasswordFile: filepath.Join(dir, "password")}
+
+	_, err := provider.Credentials(ctx)
+	assert.Error(t, err)
+
+	assert.NoError(t, os.WriteFile(provider.UsernameFile, []byte("admin\n"), 0o600))
+	assert.NoError(t, os.WriteFile(provider.PasswordFile, []byte("password\n"), 0o600))
+	credentials, err := provider.Credentials(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, &Credentials{Username: "admin", Password: "password"}, credentials)
+
+	// Rotated passwords are read again
+	assert.NoError(t, os.WriteFile(provider.PasswordFile, []byte("rotated"), 0o600))
+	credentials, err = provider.Credentials(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "rotated", credentials.Password)
+
+	assert.NoError(t, os.WriteFile(provider.PasswordFile, nil, 0o600))
+	_, err = provider.Credentials(ctx)
+	assert.Error(t, err)
+
+	_, err = (&FileCredentialProvider{}).Credentials(ctx)
+	assert.Error(t, err)
+
+	fmt.Println("File Credential Provider Test Successful")
+}
+
+func TestAuthenticateWithCredentialProvider(t *testing.T) {
+	fmt.Println("Begin - Authenticate With Credential Provider Test")
+	ctx := context.Background()
+	mockClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: mockClient}
+
+	password := "password"
+	provider := CredentialProviderFunc(func(_ context.Context) (*Credentials, error) {
+		return &Credentials{Username: "admin", Password: password}, nil
+	})
+	authorization := func(password string) interface{} {
+		return mock.MatchedBy(func(headers map[string]string) bool {
+			return headers[api.AuthorizationHeader] == "Basic "+basicAuth("admin", password)
+		})
+	}
+	loginResponse := func() *http.Response {
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(""))}
+	}
+	mockClient.On("SetToken", mock.Anything).Return()
+	mockClient.On("DoAndGetResponseBody", mock.Anything, "GET", "/api/types/basicSystemInfo/instances", mock.Anything, mock.Anything).Return(nil, errors.New("unreachable"))
+	mockClient.On("DoAndGetResponseBody", mock.Anything, "GET", "/api/types/loginSessionInfo", authorization("password"), mock.Anything).Return(loginResponse(), nil).Once()
+	assert.NoError(t, client.Authenticate(ctx, &ConfigConnect{Credentials: provider}))
+
+	// The re-authentication of an expired session fetches the rotated password
+	password = "rotated"
+	mockClient.On("DoAndGetResponseBody", mock.Anything, "GET", "/api/types/loginSessionInfo", authorization("rotated"), mock.Anything).Return(loginResponse(), nil).Once()
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/loginSessionInfo/instances", mock.Anything, mock.Anything, mock.Anything).Return(
+		&types.Error{ErrorContent: types.ErrorContent{HTTPStatusCode: http.StatusUnauthorized}}).Once()
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/loginSessionInfo/instances", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	assert.NoError(t, client.executeWithRetryAuthenticate(ctx, http.MethodGet, "/api/types/loginSessionInfo/instances", nil, &types.ListLoginSession{}))
+
+	// A nil configuration reuses the previous one
+	mockClient.On("DoAndGetResponseBody", mock.Anything, "GET", "/api/types/loginSessionInfo", authorization("rotated"), mock.Anything).Return(loginResponse(), nil).Once()
+	assert.NoError(t, client.Authenticate(ctx, nil))
+	mockClient.AssertExpectations(t)
+
+	// Negative cases
+	failing := CredentialProviderFunc(func(_ context.Context) (*Credentials, error) {
+		return nil, errors.New("vault unavailable")
+	})
+	assert.Error(t, client.Authenticate(ctx, &ConfigConnect{Credentials: failing}))
+	assert.Error(t, (&UnityClientImpl{api: mockClient}).Authenticate(ctx, nil))
+
+	fmt.Println("Authenticate With Credential Provider Test Successful")
+}
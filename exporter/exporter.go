@@ -0,0 +1,397 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package exporter exposes the capacity, health and performance of a Unity array in the Prometheus text format.
+// The exposition format is written directly so that the library does not depend on the Prometheus client.
+package exporter
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dell/gounity"
+	"github.com/dell/gounity/types"
+	"github.com/dell/gounity/util"
+)
+
+// Defaults of the exporter configuration
+const (
+	DefaultCollectionInterval  = time.Minute
+	DefaultPerformanceInterval = 60
+)
+
+// lunPageSize is the number of LUNs listed per request
+var lunPageSize = 1000
+
+const (
+	namespace = "unity"
+	// contentType is the content type of the Prometheus text format
+	contentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// Config holds the exporter configuration
+type Config struct {
+	// CollectionInterval is how often capacity and health are collected. Defaults to DefaultCollectionInterval.
+	CollectionInterval time.Duration
+	// PerformancePaths are the real time metric paths exported, e.g. sp.*.cpu.summary.busyTicks. Counters are
+	// exported as per second rates. No performance metrics are exported when empty.
+	PerformancePaths []string
+	// PerformanceInterval is the real time query interval in seconds. Defaults to DefaultPerformanceInterval.
+	PerformanceInterval int
+	// Logger logs the collection failures. Defaults to the shared logrus logger.
+	Logger util.Logger
+}
+
+// Exporter collects the metrics of a Unity array and serves them on /metrics
+type Exporter struct {
+	client gounity.UnityClient
+	config Config
+
+	mutex       sync.RWMutex
+	inventory   []sample
+	performance map[string]performanceSample
+}
+
+// performanceSample is a real time metric sample with the time it was last received
+type performanceSample struct {
+	sample
+	updated time.Time
+}
+
+// sample is a single value of a metric family
+type sample struct {
+	name   string
+	help   string
+	labels [][2]string
+	value  float64
+}
+
+// New creates an Exporter collecting the metrics of the array the client is connected to
+func New(client gounity.UnityClient, config Config) (*Exporter, error) {
+	if client == nil {
+		return nil, errors.New("unity client shouldn't be nil")
+	}
+	if config.CollectionInterval <= 0 {
+		config.CollectionInterval = DefaultCollectionInterval
+	}
+	if config.PerformanceInterval <= 0 {
+		config.PerformanceInterval = DefaultPerformanceInterval
+	}
+	return &Exporter{
+		client:      client,
+		config:      config,
+		performance: make(map[string]performanceSample),
+	}, nil
+}
+
+// Run collects the metrics at the configured intervals until ctx is cancelled
+func (e *Exporter) Run(ctx context.Context) {
+	log := util.ContextLogger(ctx, e.config.Logger)
+	if len(e.config.PerformancePaths) > 0 {
+		go e.runPerformance(ctx)
+	}
+	ticker := time.NewTicker(e.config.CollectionInterval)
+	defer ticker.Stop()
+	for {
+		if err := e.Collect(ctx); err != nil {
+			log.Warnf("Unity metrics collection failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Collect collects the capacity and health of the array once. The metrics which could be collected are kept
+// when some of the queries fail, and unity_up is set to 0.
+func (e *Exporter) Collect(ctx context.Context) error {
+	start := time.Now()
+	var samples []sample
+	var errs []error
+
+	capacity, err := e.client.GetCapacity(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("system capacity: %v", err))
+	} else {
+		for _, entry := range capacity.Entries {
+			labels := [][2]string{{"system_id", entry.Content.ID}}
+			samples = append(samples,
+				sample{"system_size_free_bytes", "Free capacity of the system.", labels, float64(entry.Content.SizeFree)},
+				sample{"system_size_total_bytes", "Total capacity of the system.", labels, float64(entry.Content.SizeTotal)},
+				sample{"system_size_used_bytes", "Used capacity of the system.", labels, float64(entry.Content.SizeUsed)},
+				sample{"system_size_preallocated_bytes", "Preallocated capacity of the system.", labels, float64(entry.Content.SizePreallocated)},
+				sample{"system_size_subscribed_bytes", "Subscribed capacity of the system.", labels, float64(entry.Content.SizeSubscribed)},
+			)
+		}
+	}
+
+	pools, err := e.client.ListStoragePools(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("storage pools: %v", err))
+	}
+	for _, pool := range pools {
+		p := pool.StoragePoolContent
+		labels := [][2]string{{"pool_id", p.ID}, {"pool_name", p.Name}}
+		samples = append(samples,
+			sample{"pool_size_free_bytes", "Free capacity of the storage pool.", labels, float64(p.FreeCapacity)},
+			sample{"pool_size_total_bytes", "Total capacity of the storage pool.", labels, float64(p.TotalCapacity)},
+			sample{"pool_size_used_bytes", "Used capacity of the storage pool.", labels, float64(p.UsedCapacity)},
+			sample{"pool_size_subscribed_bytes", "Subscribed capacity of the storage pool.", labels, float64(p.SubscribedCapacity)},
+		)
+	}
+
+	volumes, err := e.listVolumes(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("LUNs: %v", err))
+	}
+	for _, volume := range volumes {
+		v := volume.VolumeContent
+		labels := [][2]string{{"lun_id", v.ResourceID}, {"lun_name", v.Name}, {"pool_id", v.Pool.ID}}
+		samples = append(samples,
+			sample{"lun_size_total_bytes", "Size of the LUN.", labels, float64(v.SizeTotal)},
+			sample{"lun_size_used_bytes", "Used capacity of the LUN.", labels, float64(v.SizeUsed)},
+			sample{"lun_size_allocated_bytes", "Capacity allocated to the LUN in its pool.", labels, float64(v.SizeAllocated)},
+			sample{"lun_health", "Health value of the LUN, 5 is OK.", labels, float64(v.Health.Value)},
+		)
+	}
+
+	filesystems, err := e.client.ListFilesystems(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("filesystems: %v", err))
+	}
+	for _, filesystem := range filesystems {
+		f := filesystem.FileContent
+		labels := [][2]string{{"filesystem_id", f.ID}, {"filesystem_name", f.Name}, {"pool_id", f.Pool.ID}}
+		samples = append(samples,
+			sample{"filesystem_size_total_bytes", "Size of the filesystem.", labels, float64(f.SizeTotal)},
+			sample{"filesystem_size_used_bytes", "Used capacity of the filesystem.", labels, float64(f.SizeUsed)},
+			sample{"filesystem_size_allocated_bytes", "Capacity allocated to the filesystem in its pool.", labels, float64(f.SizeAllocated)},
+			sample{"filesystem_health", "Health value of the filesystem, 5 is OK.", labels, float64(f.Health.Value)},
+		)
+	}
+
+	session := e.client.SessionStats()
+	samples = append(samples,
+		sample{"session_age_seconds", "Age of the login session with the array.", nil, session.Age.Seconds()},
+		sample{"session_authentications_total", "Number of logins to the array, including re-authentications.", nil, float64(session.Authentications)},
+		sample{"session_keepalive_failures_total", "Number of failed session keepalive requests.", nil, float64(session.KeepaliveFailures)},
+	)
+
+	up := 1.0
+	if len(errs) > 0 {
+		up = 0
+	}
+	samples = append(samples,
+		sample{"up", "Whether the last collection from the array succeeded.", nil, up},
+		sample{"collection_duration_seconds", "Duration of the last collection from the array.", nil, time.Since(start).Seconds()},
+	)
+
+	e.mutex.Lock()
+	e.inventory = samples
+	e.mutex.Unlock()
+	return errors.Join(errs...)
+}
+
+// listVolumes lists the LUNs of all the pages
+func (e *Exporter) listVolumes(ctx context.Context) ([]types.Volume, error) {
+	var volumes []types.Volume
+	for page := 1; ; page++ {
+		result, _, err := e.client.ListVolumes(ctx, page, lunPageSize)
+		if err != nil {
+			return volumes, err
+		}
+		volumes = append(volumes, result...)
+		if len(result) < lunPageSize {
+			return volumes, nil
+		}
+	}
+}
+
+// runPerformance streams the configured real time metrics until ctx is cancelled, restarting the stream when it fails
+func (e *Exporter) runPerformance(ctx context.Context) {
+	log := util.ContextLogger(ctx, e.config.Logger)
+	for {
+		if err := e.streamPerformance(ctx); err != nil {
+			log.Warnf("Unity performance metrics stream failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(e.config.CollectionInterval):
+		}
+	}
+}
+
+func (e *Exporter) streamPerformance(ctx context.Context) error {
+	catalog, err := e.client.ListMetrics(ctx, &gounity.MetricFilter{Realtime: true})
+	if err != nil {
+		return err
+	}
+	rates := gounity.NewMetricRateCalculator(catalog)
+	stream, err := e.client.NewMetricsStream(ctx, e.config.PerformancePaths, e.config.PerformanceInterval)
+	if err != nil {
+		return err
+	}
+	for metricSample := range stream.Samples() {
+		value, unit, ok := rates.Rate(metricSample)
+		if !ok {
+			continue
+		}
+		s := sample{
+			name:   "performance_metric",
+			help:   "Real time performance metric of the array. Counters are exported as per second rates.",
+			labels: [][2]string{{"path", metricSample.Path}, {"instance_key", metricSample.InstanceKey}, {"unit", unit}},
+			value:  value,
+		}
+		e.mutex.Lock()
+		e.performance[metricSample.Path+"|"+metricSample.InstanceKey] = performanceSample{sample: s, updated: time.Now()}
+		e.mutex.Unlock()
+	}
+	return stream.Err()
+}
+
+// performanceTTL is how long a real time sample is exported without being refreshed. A sample missing from the
+// last stream interval, e.g. of a deleted LUN, is dropped, one more interval being allowed for the polling delay.
+func (e *Exporter) performanceTTL() time.Duration {
+	return 2 * time.Duration(e.config.PerformanceInterval) * time.Second
+}
+
+// dropStalePerformance drops the real time samples which were not refreshed within performanceTTL
+func (e *Exporter) dropStalePerformance() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	for key, s := range e.performance {
+		if time.Since(s.updated) > e.performanceTTL() {
+			delete(e.performance, key)
+		}
+	}
+}
+
+// ServeHTTP writes the latest collected metrics in the Prometheus text format
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	e.dropStalePerformance()
+	e.mutex.RLock()
+	samples := make([]sample, 0, len(e.inventory)+len(e.performance))
+	samples = append(samples, e.inventory...)
+	for _, s := range e.performance {
+		samples = append(samples, s.sample)
+	}
+	e.mutex.RUnlock()
+
+	w.Header().Set("Content-Type", contentType)
+	bw := bufio.NewWriter(w)
+	writeSamples(bw, samples)
+	_ = bw.Flush()
+}
+
+// writeSamples writes the samples grouped by metric family, ordered by name and labels
+func writeSamples(w *bufio.Writer, samples []sample) {
+	lines := make([]string, len(samples))
+	for i, s := range samples {
+		lines[i] = formatSample(s)
+	}
+	order := make([]int, len(samples))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		if samples[order[i]].name != samples[order[j]].name {
+			return samples[order[i]].name < samples[order[j]].name
+		}
+		return lines[order[i]] < lines[order[j]]
+	})
+
+	family := ""
+	for _, i := range order {
+		if samples[i].name != family {
+			family = samples[i].name
+			fmt.Fprintf(w, "# HELP %s_%s %s\n", namespace, family, escapeHelp(samples[i].help))
+			fmt.Fprintf(w, "# TYPE %s_%s %s\n", namespace, family, metricType(family))
+		}
+		w.WriteString(lines[i])
+	}
+}
+
+// counters are the metric families which only increase, the others are gauges
+var counters = map[string]bool{
+	"session_authentications_total":    true,
+	"session_keepalive_failures_total": true,
+}
+
+func metricType(family string) string {
+	if counters[family] {
+		return "counter"
+	}
+	return "gauge"
+}
+
+func formatSample(s sample) string {
+	var b strings.Builder
+	b.WriteString(namespace + "_" + s.name)
+	if len(s.labels) > 0 {
+		b.WriteByte('{')
+		for i, label := range s.labels {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(label[0] + "=\"" + escapeLabelValue(label[1]) + "\"")
+		}
+		b.WriteByte('}')
+	}
+	b.WriteByte(' ')
+	b.WriteString(formatValue(s.value))
+	b.WriteByte('\n')
+	return b.String()
+}
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+var (
+	labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	helpReplacer       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+)
+
+func escapeLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
+
+func escapeHelp(help string) string {
+	return helpReplacer.Replace(help)
+}
@@ -0,0 +1,231 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dell/gounity"
+	"github.com/dell/gounity/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeUnity serves canned responses of the Unity REST API
+type fakeUnity struct {
+	mutex     sync.Mutex
+	responses map[string]string
+	deleted   bool
+}
+
+func (f *fakeUnity) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if r.Method == http.MethodDelete {
+		f.deleted = true
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	response, ok := f.responses[r.Method+" "+r.URL.Path+"?page="+r.URL.Query().Get("page")]
+	if !ok {
+		response, ok = f.responses[r.Method+" "+r.URL.Path]
+	}
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, `{"error":{"httpStatusCode":404,"messages":[{"en-US":"not found"}]}}`)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = io.WriteString(w, response)
+}
+
+func newFakeUnity() *fakeUnity {
+	return &fakeUnity{responses: map[string]string{
+		"GET /api/types/systemCapacity/instances":       `{"entries":[{"content":{"id":"0","sizeFree":100,"sizeTotal":300,"sizeUsed":200,"sizePreallocated":10,"sizeSubscribed":400}}]}`,
+		"GET /api/types/pool/instances":                 `{"entries":[{"content":{"id":"pool_1","name":"pool \"one\"","sizeFree":50,"sizeTotal":150,"sizeUsed":100,"sizeSubscribed":200}}]}`,
+		"GET /api/types/lun/instances":                  `{"entries":[{"content":{"id":"sv_1","name":"lun1","sizeTotal":64,"sizeUsed":16,"sizeAllocated":32,"pool":{"id":"pool_1"},"health":{"value":5}}}]}`,
+		"GET /api/types/filesystem/instances":           `{"entries":[{"content":{"id":"fs_1","name":"fs1","sizeTotal":128,"sizeUsed":8,"sizeAllocated":24,"pool":{"id":"pool_1"},"health":{"value":20}}}]}`,
+		"GET /api/types/metric/instances":               `{"entries":[{"content":{"id":1,"path":"sp.*.storage.summary.readsRate","type":4,"unitDisplayString":"I/O/s","isRealtimeAvailable":true}}]}`,
+		"POST /api/types/metricRealTimeQuery/instances": `{"content":{"id":7,"interval":1}}`,
+		"GET /api/types/metricQueryResult/instances":    `{"entries":[{"content":{"queryId":7,"path":"sp.*.storage.summary.readsRate","timestamp":"2025-01-01T00:00:00.000Z","values":{"spa":12.5}}}]}`,
+	}}
+}
+
+func scrape(t *testing.T, e *Exporter) string {
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, contentType, recorder.Header().Get("Content-Type"))
+	return recorder.Body.String()
+}
+
+func TestExporter(t *testing.T) {
+	fake := newFakeUnity()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, err := gounity.NewClientWithArgs(ctx, server.URL, true)
+	require.NoError(t, err)
+	e, err := New(client, Config{
+		CollectionInterval:  time.Hour,
+		PerformancePaths:    []string{"sp.*.storage.summary.readsRate"},
+		PerformanceInterval: 1,
+	})
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		e.Run(ctx)
+		close(done)
+	}()
+
+	var body string
+	require.Eventually(t, func() bool {
+		body = scrape(t, e)
+		return strings.Contains(body, "unity_performance_metric")
+	}, 10*time.Second, 50*time.Millisecond)
+
+	expected := []string{
+		"# TYPE unity_system_size_total_bytes gauge\n",
+		"# TYPE unity_session_authentications_total counter\n",
+		"# TYPE unity_session_keepalive_failures_total counter\n",
+		`unity_system_size_total_bytes{system_id="0"} 300` + "\n",
+		`unity_pool_size_subscribed_bytes{pool_id="pool_1",pool_name="pool \"one\""} 200` + "\n",
+		`unity_lun_size_allocated_bytes{lun_id="sv_1",lun_name="lun1",pool_id="pool_1"} 32` + "\n",
+		`unity_lun_health{lun_id="sv_1",lun_name="lun1",pool_id="pool_1"} 5` + "\n",
+		`unity_filesystem_size_used_bytes{filesystem_id="fs_1",filesystem_name="fs1",pool_id="pool_1"} 8` + "\n",
+		`unity_filesystem_health{filesystem_id="fs_1",filesystem_name="fs1",pool_id="pool_1"} 20` + "\n",
+		`unity_performance_metric{path="sp.*.storage.summary.readsRate",instance_key="spa",unit="I/O/s"} 12.5` + "\n",
+		"unity_up 1\n",
+	}
+	for _, line := range expected {
+		assert.Contains(t, body, line)
+	}
+	assert.Equal(t, 1, strings.Count(body, "# HELP unity_pool_size_free_bytes "))
+
+	cancel()
+	<-done
+	assert.Eventually(t, func() bool {
+		fake.mutex.Lock()
+		defer fake.mutex.Unlock()
+		return fake.deleted
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func TestExporterCollectErrors(t *testing.T) {
+	fake := newFakeUnity()
+	delete(fake.responses, "GET /api/types/pool/instances")
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	ctx := context.Background()
+
+	client, err := gounity.NewClientWithArgs(ctx, server.URL, true)
+	require.NoError(t, err)
+	e, err := New(client, Config{})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultCollectionInterval, e.config.CollectionInterval)
+	assert.Equal(t, DefaultPerformanceInterval, e.config.PerformanceInterval)
+
+	err = e.Collect(ctx)
+	assert.Error(t, err)
+	body := scrape(t, e)
+	assert.Contains(t, body, "unity_up 0\n")
+	assert.Contains(t, body, `unity_system_size_free_bytes{system_id="0"} 100`)
+	assert.NotContains(t, body, "unity_pool_size_free_bytes")
+
+	_, err = New(nil, Config{})
+	assert.Error(t, err)
+
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/metrics", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	// The failures are logged by the configured logger
+	buf := &bytes.Buffer{}
+	e, err = New(client, Config{Logger: util.NewSlogLogger(slog.NewTextHandler(buf, nil))})
+	require.NoError(t, err)
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	e.Run(cancelled)
+	assert.Contains(t, buf.String(), "Unity metrics collection failed")
+}
+
+func TestExporterPaging(t *testing.T) {
+	defer func(size int) { lunPageSize = size }(lunPageSize)
+	lunPageSize = 1
+	fake := newFakeUnity()
+	fake.responses["GET /api/types/lun/instances?page=2"] = `{"entries":[{"content":{"id":"sv_2","name":"lun2","pool":{"id":"pool_1"}}}]}`
+	fake.responses["GET /api/types/lun/instances?page=3"] = `{"entries":[]}`
+	fake.responses["GET /api/types/filesystem/instances?page=1"] = `{"links":[{"rel":"next","href":"&page=2"}],"entries":[{"content":{"id":"fs_1","name":"fs1"}}]}`
+	fake.responses["GET /api/types/filesystem/instances?page=2"] = `{"entries":[{"content":{"id":"fs_2","name":"fs2"}}]}`
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	ctx := context.Background()
+
+	client, err := gounity.NewClientWithArgs(ctx, server.URL, true)
+	require.NoError(t, err)
+	e, err := New(client, Config{})
+	require.NoError(t, err)
+	require.NoError(t, e.Collect(ctx))
+
+	body := scrape(t, e)
+	for _, line := range []string{
+		`unity_lun_size_total_bytes{lun_id="sv_1",lun_name="lun1",pool_id="pool_1"} 64`,
+		`unity_lun_size_total_bytes{lun_id="sv_2",lun_name="lun2",pool_id="pool_1"} 0`,
+		`unity_filesystem_size_total_bytes{filesystem_id="fs_1",filesystem_name="fs1",pool_id=""} 0`,
+		`unity_filesystem_size_total_bytes{filesystem_id="fs_2",filesystem_name="fs2",pool_id=""} 0`,
+	} {
+		assert.Contains(t, body, line)
+	}
+}
+
+func TestExporterDropsStalePerformance(t *testing.T) {
+	server := httptest.NewServer(newFakeUnity())
+	defer server.Close()
+	client, err := gounity.NewClientWithArgs(context.Background(), server.URL, true)
+	require.NoError(t, err)
+	e, err := New(client, Config{PerformanceInterval: 5})
+	require.NoError(t, err)
+
+	fresh := sample{name: "performance_metric", labels: [][2]string{{"path", "sp.*.cpu.summary.busyTicks"}, {"instance_key", "spa"}}, value: 1}
+	stale := sample{name: "performance_metric", labels: [][2]string{{"path", "lun.*.reads"}, {"instance_key", "sv_deleted"}}, value: 2}
+	e.performance["fresh"] = performanceSample{sample: fresh, updated: time.Now()}
+	e.performance["stale"] = performanceSample{sample: stale, updated: time.Now().Add(-time.Minute)}
+
+	body := scrape(t, e)
+	assert.Contains(t, body, `instance_key="spa"`)
+	assert.NotContains(t, body, "sv_deleted")
+	assert.Len(t, e.performance, 1)
+}
+
+func TestFormatSample(t *testing.T) {
+	assert.Equal(t, "unity_up 1\n", formatSample(sample{name: "up", value: 1}))
+	assert.Equal(t, "unity_x{a=\"b\\\\c\\nd\"} NaN\n", formatSample(sample{name: "x", labels: [][2]string{{"a", "b\\c\nd"}}, value: math.NaN()}))
+	assert.Equal(t, "+Inf", formatValue(math.Inf(1)))
+	assert.Equal(t, "-Inf", formatValue(math.Inf(-1)))
+	assert.Equal(t, "1.5e+12", formatValue(1.5e12))
+}
@@ -16,10 +16,10 @@ package gounity
 
 const (
 	// LunDisplayFields to display the Volume fields
-	LunDisplayFields = "id,name,description,type,wwn,sizeTotal,sizeUsed,sizeAllocated,hostAccess,pool,tieringPolicy,ioLimitPolicy,isThinEnabled,isDataReductionEnabled,isThinClone,parentSnap,originalParentLun?fields,health"
+	LunDisplayFields = "id,name,description,type,wwn,sizeTotal,sizeUsed,sizeAllocated,hostAccess,pool,tieringPolicy,ioLimitPolicy,isThinEnabled,isDataReductionEnabled,isThinClone,parentSnap,originalParentLun?fields,health,snapsSizeAllocated,dataReductionSizeSaved"
 
 	// FileSystemDisplayFields to display the File System fields
-	FileSystemDisplayFields = "id,name,description,type,sizeTotal,isThinEnabled,isDataReductionEnabled,pool,nasServer,storageResource,nfsShare?fields,cifsShare,tieringPolicy,hostIOSize,health"
+	FileSystemDisplayFields = "id,name,description,type,sizeTotal,sizeUsed,sizeAllocated,isThinEnabled,isDataReductionEnabled,pool,nasServer,storageResource,nfsShare?fields,cifsShare,tieringPolicy,hostIOSize,health,snapsSizeAllocated,dataReductionSizeSaved"
 
 	// StorageResourceDisplayFields to display Storage Resource fields
 	StorageResourceDisplayFields = "id,name,filesystem"
@@ -33,6 +33,9 @@ const (
 	// NasServerDisplayfields to display the NAS Server fields
 	NasServerDisplayfields = "id,name,nfsServer?fields"
 
+	// NasServerListFields to display the NAS Server fields when listing
+	NasServerListFields = "id,name,tenant"
+
 	// SnapshotDisplayFields to display the Snapshot fields
 	SnapshotDisplayFields = "id,name,description,storageResource?,lun,creationTime,expirationTime,lastRefreshTime,state,size,isAutoDelete,accessType,parentSnap"
 
@@ -43,14 +46,62 @@ const (
 	HostIPPortDisplayFields = "id,address"
 
 	// LicenseInfoDisplayFields to display License Info fields
-	LicenseInfoDisplayFields = "isInstalled,isValid"
+	LicenseInfoDisplayFields = "id,name,version,isInstalled,isValid,isPermanent,issued,expires,feature"
 
 	// HostInitiatorPathDisplayFields to display the HostInitiatorPath fields
 	HostInitiatorPathDisplayFields = "fcPort"
 
+	// HostInitiatorPathListFields to display the HostInitiatorPath fields along with the FC port / iSCSI portal it is logged in to
+	HostInitiatorPathListFields = "id,isLoggedIn,hostPushName,sessionIds,initiator,fcPort.id,fcPort.wwn,iscsiPortal.id,iscsiPortal.ipAddress"
+
 	// FcPortDisplayFields to display the FC Port fields
 	FcPortDisplayFields = "wwn"
 
+	// AlertFields to display the Alert fields
+	AlertFields = "id,timestamp,severity,state,component,messageId,message,description,resolution,isAcknowledged"
+
+	// EventFields to display the Event fields
+	EventFields = "id,node,creationTime,severity,messageId,message,username,category,source"
+
+	// MetricInfoFields to display the Metric fields
+	MetricInfoFields = "id,name,path,product,type,description,isHistoricalAvailable,isRealtimeAvailable,unit,unitDisplayString,visibility"
+
+	// FcPortListFields to display the FC Port fields when listing
+	FcPortListFields = "id,name,wwn,slotNumber,currentSpeed,availableSpeeds,storageProcessor,health"
+
+	// EthernetPortFields to display the Ethernet Port fields
+	EthernetPortFields = "id,name,portNumber,speed,mtu,macAddress,isLinkUp,storageProcessor,health"
+
+	// IscsiPortalFields to display the iSCSI Portal fields
+	IscsiPortalFields = "id,ipAddress,netmask,gateway,vlanId,ipProtocolVersion,ethernetPort.id,ethernetPort.storageProcessor.id,iscsiNode.id,iscsiNode.name"
+
+	// SystemFields to display the System fields
+	SystemFields = "id,name,model,serialNumber,uuidBase,platform,macAddress,health"
+
+	// FeatureFields to display the Feature fields
+	FeatureFields = "id,name,state,reason"
+
+	// StorageProcessorFields to display the Storage Processor fields
+	StorageProcessorFields = "id,name,health,slotNumber,model,emcSerialNumber,emcPartNumber,biosFirmwareRevision,memorySize"
+
+	// DiskFields to display the Disk fields
+	DiskFields = "id,name,health,slotNumber,model,emcSerialNumber,emcPartNumber,version,size"
+
+	// EnclosureFields to display the DPE and DAE fields
+	EnclosureFields = "id,name,health,model,emcSerialNumber,emcPartNumber"
+
+	// PowerSupplyFields to display the Power Supply and Battery fields
+	PowerSupplyFields = "id,name,health,slotNumber,model,emcSerialNumber,emcPartNumber,firmwareVersion"
+
+	// FanFields to display the Fan fields
+	FanFields = "id,name,health,slotNumber,emcSerialNumber,emcPartNumber"
+
+	// MemoryModuleFields to display the Memory Module fields
+	MemoryModuleFields = "id,name,health,slotNumber,model,emcSerialNumber,emcPartNumber,manufacturer,size"
+
+	// IoModuleFields to display the IO Module fields
+	IoModuleFields = "id,name,health,slotNumber,model,emcSerialNumber,emcPartNumber"
+
 	// HostIOLimitFields to display host IO limit fields
 	HostIOLimitFields = "id,name,description"
 
@@ -58,14 +109,23 @@ const (
 	IscsiIPFields = "id,ipAddress,type"
 
 	// HostfieldsToQuery to display host fields
-	HostfieldsToQuery = "id,name,description,fcHostInitiators,iscsiHostInitiators,hostIPPorts?fields"
+	HostfieldsToQuery = "id,name,description,type,osType,tenant,health,hostLUNs,fcHostInitiators,iscsiHostInitiators,hostIPPorts?fields"
+
+	// HostLUNDisplayFields to display the HostLUN fields
+	HostLUNDisplayFields = "id,host,type,hlu,lun,snap,isReadOnly"
 
 	// StoragePoolFields to display Storage Pool fields
-	StoragePoolFields = "id,name,description,sizeFree,sizeTotal,sizeUsed,sizeSubscribed,hasDataReductionEnabledLuns,hasDataReductionEnabledFs,isFASTCacheEnabled,type,isAllFlash,poolFastVP"
+	StoragePoolFields = "id,name,description,sizeFree,sizeTotal,sizeUsed,sizeSubscribed,hasDataReductionEnabledLuns,hasDataReductionEnabledFs,isFASTCacheEnabled,type,isAllFlash,poolFastVP,alertThreshold,isFASTVpScheduleEnabled,raidType,dataReductionSizeSaved,dataReductionPercent,dataReductionRatio,snapSizeUsed,tiers"
 
 	// SystemCapacityFields to display system capacity details
 	SystemCapacityFields = "id,sizeFree,sizeTotal,sizeUsed,sizePreallocated,sizeSubscribed,totalLogicalSize"
 
+	// LoginSessionFields to display the Login Session fields
+	LoginSessionFields = "id,idleTimeout"
+
+	// SystemLimitFields to display the System Limit fields
+	SystemLimitFields = "id,name,description,unit,limitValue,thresholdValue"
+
 	// MaximumVolumeSize to display limit and unit
 	MaximumVolumeSize = "limitValue,unit"
 )
@@ -13,8 +13,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/dell/gounity/util"
-
 	"github.com/dell/gounity/api"
 	"github.com/dell/gounity/types"
 )
@@ -77,7 +75,7 @@ func (c *UnityClientImpl) FindFilesystemByName(ctx context.Context, filesystemNa
 
 // FindFilesystemByID - Find the Filesystem by it's Id. If the Filesystem is not found, an error will be returned.
 func (c *UnityClientImpl) FindFilesystemByID(ctx context.Context, filesystemID string) (*types.Filesystem, error) {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	if len(filesystemID) == 0 {
 		return nil, errors.New("Filesystem Id shouldn't be empty")
 	}
@@ -93,6 +91,22 @@ func (c *UnityClientImpl) FindFilesystemByID(ctx context.Context, filesystemID s
 	return fileSystemResp, nil
 }
 
+// ListFilesystems - List all the Filesystems of the array
+func (c *UnityClientImpl) ListFilesystems(ctx context.Context) ([]types.Filesystem, error) {
+	var filesystems []types.Filesystem
+	for page := 1; ; page++ {
+		result := &types.ListFilesystem{}
+		err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, pagedListURI(api.FileSystemAction, nil, FileSystemDisplayFields, page), nil, result)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list filesystems %v", err)
+		}
+		filesystems = append(filesystems, result.Filesystems...)
+		if len(result.Filesystems) == 0 || !hasNextPage(result.Links) {
+			return filesystems, nil
+		}
+	}
+}
+
 // GetFilesystemIDFromResID - Returns the filesystem ID for the filesystem
 func (c *UnityClientImpl) GetFilesystemIDFromResID(ctx context.Context, filesystemResID string) (string, error) {
 	if filesystemResID == "" {
@@ -109,7 +123,7 @@ func (c *UnityClientImpl) GetFilesystemIDFromResID(ctx context.Context, filesyst
 
 // CreateFilesystem - Create a new filesystem on the array
 func (c *UnityClientImpl) CreateFilesystem(ctx context.Context, name, storagepool, description, nasServer string, size uint64, tieringPolicy, hostIOSize, supportedProtocol int, isThinEnabled, isDataReductionEnabled bool) (*types.Filesystem, error) {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	if name == "" {
 		return nil, errors.New("filesystem name should not be empty")
 	}
@@ -118,6 +132,12 @@ func (c *UnityClientImpl) CreateFilesystem(ctx context.Context, name, storagepoo
 		return nil, fmt.Errorf("filesystem name %s should not exceed %d characters", name, FsNameMaxLength)
 	}
 
+	if isDataReductionEnabled {
+		if err := c.Capabilities().Require(DataReductionCapability); err != nil {
+			return nil, err
+		}
+	}
+
 	pool, err := c.FindStoragePoolByID(ctx, storagepool)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get PoolID (%s) Error:%v", storagepool, err)
@@ -198,7 +218,7 @@ func (c *UnityClientImpl) CreateFilesystem(ctx context.Context, name, storagepoo
 
 // DeleteFilesystem delete by its ID. If the Filesystem is not present on the array, an error will be returned.
 func (c *UnityClientImpl) DeleteFilesystem(ctx context.Context, filesystemID string) error {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	if len(filesystemID) == 0 {
 		return errors.New("Filesystem Id cannot be empty")
 	}
@@ -288,6 +308,9 @@ func (c *UnityClientImpl) CreateNFSShareFromSnapshot(ctx context.Context, name,
 	if len(snapshotID) == 0 {
 		return nil, errors.New("Snapshot Id cannot be empty")
 	}
+	if err := c.Capabilities().Require(NFSShareFromSnapshotCapability); err != nil {
+		return nil, err
+	}
 
 	snapshotContent := types.SnapshotIDContent{
 		ID: snapshotID,
@@ -337,7 +360,7 @@ func (c *UnityClientImpl) FindNFSShareByID(ctx context.Context, nfsShareID strin
 
 // ModifyNFSShareHostAccess - Modify the host access on NFS Share
 func (c *UnityClientImpl) ModifyNFSShareHostAccess(ctx context.Context, filesystemID, nfsShareID string, hostIDs []string, accessType AccessType) error {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	if len(filesystemID) == 0 {
 		return errors.New("Filesystem Id cannot be empty")
 	}
@@ -424,7 +447,7 @@ func (c *UnityClientImpl) ModifyNFSShareCreatedFromSnapshotHostAccess(ctx contex
 
 // DeleteNFSShare by its ID. If the NFSShare is not present on the array, an error will be returned.
 func (c *UnityClientImpl) DeleteNFSShare(ctx context.Context, filesystemID, nfsShareID string) error {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 
 	if len(filesystemID) == 0 {
 		return errors.New("Filesystem Id cannot be empty")
@@ -497,7 +520,7 @@ func (c *UnityClientImpl) FindNASServerByID(ctx context.Context, nasServerID str
 
 // ExpandFilesystem Filesystem Expand volume to provided capacity
 func (c *UnityClientImpl) ExpandFilesystem(ctx context.Context, filesystemID string, newSize uint64) error {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	filesystem, err := c.FindFilesystemByID(ctx, filesystemID)
 	if err != nil {
 		return fmt.Errorf("unable to find filesystem Id %s. Error: %v", filesystemID, err)
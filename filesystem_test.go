@@ -143,6 +143,34 @@ func TestFindFilesystem(t *testing.T) {
 	fmt.Println("Find Filesystem test successful")
 }
 
+func TestListFilesystems(t *testing.T) {
+	fmt.Println("Begin - List Filesystems Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	listURI := "/api/types/filesystem/instances?fields=" + FileSystemDisplayFields + "&per_page=1000&page=%d"
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf(listURI, 1), mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListFilesystem")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListFilesystem)
+		resp.Filesystems = []types.Filesystem{{FileContent: types.FileContent{ID: "fs_1", SizeUsed: 1024, SizeAllocated: 2048}}}
+		resp.Links = []types.Link{{Rel: "next"}}
+	}).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf(listURI, 2), mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListFilesystem")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListFilesystem)
+		resp.Filesystems = []types.Filesystem{{FileContent: types.FileContent{ID: "fs_2"}}}
+	}).Once()
+	filesystems, err := testConf.client.ListFilesystems(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, filesystems, 2)
+	assert.Equal(t, uint64(2048), filesystems[0].FileContent.SizeAllocated)
+	assert.Equal(t, "fs_2", filesystems[1].FileContent.ID)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("list failed")).Once()
+	_, err = testConf.client.ListFilesystems(ctx)
+	assert.Error(t, err)
+
+	fmt.Println("List Filesystems Test Successful")
+}
+
 func TestCreateNfsShare(t *testing.T) {
 	fmt.Println("Begin - Create NFS Share Test")
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
@@ -0,0 +1,113 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dell/gounity/types"
+)
+
+// HardwareComponentType is the Unity resource type of a hardware component
+type HardwareComponentType string
+
+// Hardware component types
+const (
+	StorageProcessorComponent HardwareComponentType = "storageProcessor"
+	DiskComponent             HardwareComponentType = "disk"
+	DpeComponent              HardwareComponentType = "dpe"
+	DaeComponent              HardwareComponentType = "dae"
+	PowerSupplyComponent      HardwareComponentType = "powerSupply"
+	FanComponent              HardwareComponentType = "fan"
+	BatteryComponent          HardwareComponentType = "battery"
+	MemoryModuleComponent     HardwareComponentType = "memoryModule"
+	IoModuleComponent         HardwareComponentType = "ioModule"
+)
+
+// HealthOK is the health value of a component working normally
+const HealthOK = 5
+
+// HardwareComponentTypes lists every hardware component type, in the order used by SystemHealthSummary
+var HardwareComponentTypes = []HardwareComponentType{
+	StorageProcessorComponent,
+	DpeComponent,
+	DaeComponent,
+	DiskComponent,
+	PowerSupplyComponent,
+	FanComponent,
+	BatteryComponent,
+	MemoryModuleComponent,
+	IoModuleComponent,
+}
+
+// hardwareComponentFields holds the fields requested for each hardware component type
+var hardwareComponentFields = map[HardwareComponentType]string{
+	StorageProcessorComponent: StorageProcessorFields,
+	DiskComponent:             DiskFields,
+	DpeComponent:              EnclosureFields,
+	DaeComponent:              EnclosureFields,
+	PowerSupplyComponent:      PowerSupplyFields,
+	FanComponent:              FanFields,
+	BatteryComponent:          PowerSupplyFields,
+	MemoryModuleComponent:     MemoryModuleFields,
+	IoModuleComponent:         IoModuleFields,
+}
+
+// ListHardwareComponents lists the hardware components of the given type with their health, slot, firmware, serial number and model
+func (c *UnityClientImpl) ListHardwareComponents(ctx context.Context, componentType HardwareComponentType) ([]types.HardwareComponent, error) {
+	log := c.log(ctx)
+	fields, ok := hardwareComponentFields[componentType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported hardware component type %s", componentType)
+	}
+
+	var components []types.HardwareComponent
+	for page := 1; ; page++ {
+		uri := pagedListURI(string(componentType), nil, fields, page)
+		log.Debugf("ListHardwareComponents: %s", uri)
+		result := &types.ListHardwareComponent{}
+		err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, uri, nil, result)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list %s components: %v", componentType, err)
+		}
+		for _, component := range result.Entries {
+			component.HardwareComponentContent.Type = string(componentType)
+			components = append(components, component)
+		}
+		if len(result.Entries) == 0 || !hasNextPage(result.Links) {
+			return components, nil
+		}
+	}
+}
+
+// SystemHealthSummary counts the hardware components of every type and lists those whose health is not OK
+func (c *UnityClientImpl) SystemHealthSummary(ctx context.Context) (*types.SystemHealthSummary, error) {
+	summary := &types.SystemHealthSummary{ComponentCounts: make(map[string]int)}
+	for _, componentType := range HardwareComponentTypes {
+		components, err := c.ListHardwareComponents(ctx, componentType)
+		if err != nil {
+			return nil, err
+		}
+		summary.ComponentCounts[string(componentType)] = len(components)
+		for _, component := range components {
+			if component.HardwareComponentContent.Health.Value != HealthOK {
+				summary.Degraded = append(summary.Degraded, component.HardwareComponentContent)
+			}
+		}
+	}
+	return summary, nil
+}
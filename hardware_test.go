@@ -0,0 +1,89 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/dell/gounity/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListHardwareComponents(t *testing.T) {
+	fmt.Println("Begin - List Hardware Components Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+	ctx := context.Background()
+
+	uri := fmt.Sprintf("/api/types/disk/instances?fields=%s&per_page=1000&page=1", DiskFields)
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", uri, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListHardwareComponent")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListHardwareComponent)
+		resp.Entries = []types.HardwareComponent{
+			{HardwareComponentContent: types.HardwareComponentContent{ID: "dpe_disk_0", SlotNumber: 0, Version: "A11C", EmcSerialNumber: "SN0", Health: types.HealthContent{Value: HealthOK}}},
+		}
+	}).Once()
+	disks, err := testConf.client.ListHardwareComponents(ctx, DiskComponent)
+	assert.NoError(t, err)
+	assert.Len(t, disks, 1)
+	assert.Equal(t, "disk", disks[0].HardwareComponentContent.Type)
+	assert.Equal(t, "A11C", disks[0].HardwareComponentContent.Firmware())
+
+	// Negative cases
+	_, err = testConf.client.ListHardwareComponents(ctx, "lcc")
+	assert.Error(t, err)
+
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListHardwareComponent")).Return(errors.New("error")).Once()
+	_, err = testConf.client.ListHardwareComponents(ctx, FanComponent)
+	assert.Error(t, err)
+
+	fmt.Println("List Hardware Components Test Successful")
+}
+
+func TestSystemHealthSummary(t *testing.T) {
+	fmt.Println("Begin - System Health Summary Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+	ctx := context.Background()
+
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListHardwareComponent")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListHardwareComponent)
+		resp.Entries = []types.HardwareComponent{
+			{HardwareComponentContent: types.HardwareComponentContent{ID: "ok", Health: types.HealthContent{Value: HealthOK}}},
+		}
+		if strings.HasPrefix(args.String(2), "/api/types/fan/") {
+			resp.Entries = append(resp.Entries, types.HardwareComponent{HardwareComponentContent: types.HardwareComponentContent{ID: "fan_1", Health: types.HealthContent{Value: 20}}})
+		}
+	}).Times(len(HardwareComponentTypes))
+	summary, err := testConf.client.SystemHealthSummary(ctx)
+	assert.NoError(t, err)
+	assert.False(t, summary.Healthy())
+	assert.Equal(t, 2, summary.ComponentCounts["fan"])
+	assert.Equal(t, 1, summary.ComponentCounts["disk"])
+	assert.Len(t, summary.Degraded, 1)
+	assert.Equal(t, "fan", summary.Degraded[0].Type)
+
+	// Negative case
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListHardwareComponent")).Return(errors.New("error")).Once()
+	_, err = testConf.client.SystemHealthSummary(ctx)
+	assert.Error(t, err)
+
+	fmt.Println("System Health Summary Test Successful")
+}
@@ -10,7 +10,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/dell/gounity/util"
 
@@ -28,7 +31,7 @@ var (
 
 // FindHostByName Finds the Host by it's name. If the Host is not found, an error will be returned.
 func (c *UnityClientImpl) FindHostByName(ctx context.Context, hostName string) (*types.Host, error) {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	if len(hostName) == 0 {
 		return nil, errors.New("host Name shouldn't be empty")
 	}
@@ -47,23 +50,86 @@ func (c *UnityClientImpl) FindHostByName(ctx context.Context, hostName string) (
 	return hResponse, nil
 }
 
+// HostOsType is string
+type HostOsType string
+
+// HostOsType constants
+const (
+	LinuxHostOsType   = HostOsType("Linux")
+	WindowsHostOsType = HostOsType("Windows")
+	ESXiHostOsType    = HostOsType("VMware ESXi")
+	AIXHostOsType     = HostOsType("AIX")
+	HPUXHostOsType    = HostOsType("HP-UX")
+	SolarisHostOsType = HostOsType("Solaris")
+)
+
+// ErrorHostHasAttachedLUNs stores error for deleting a host which still has LUNs attached
+var ErrorHostHasAttachedLUNs = errors.New("host has attached LUNs or snapshots. Remove the host access before deleting the host")
+
+// HostInitiatorSpec holds an initiator to be registered with a host on creation
+type HostInitiatorSpec struct {
+	WwnOrIqn      string
+	InitiatorType types.InitiatorType
+}
+
+// CreateHostOptions holds the parameters used to create a Host
+type CreateHostOptions struct {
+	Name        string
+	Description string
+	// OsType defaults to LinuxHostOsType when empty
+	OsType     HostOsType
+	TenantID   string
+	Initiators []HostInitiatorSpec
+}
+
+// ModifyHostOptions holds the Host parameters to be modified. Empty values are left unchanged.
+type ModifyHostOptions struct {
+	Name        string
+	Description string
+	OsType      HostOsType
+}
+
+// HostFilter narrows down the hosts returned by ListHosts. Empty values are not used for filtering.
+type HostFilter struct {
+	Name     string
+	OsType   HostOsType
+	TenantID string
+}
+
 // CreateHost Create a new Host
 func (c *UnityClientImpl) CreateHost(ctx context.Context, hostName string, tenantID string) (*types.Host, error) {
 	if len(hostName) == 0 {
 		return nil, errors.New("hostname shouldn't be empty")
 	}
-	tenantIDStruct := types.Tenants{
-		TenantID: tenantID,
-	}
-	hostReq := &types.HostCreateParam{
-		Type:        "1", // Initiator type hardcoded as "1" for FC Initiator
+	return c.CreateHostWithOptions(ctx, &CreateHostOptions{
 		Name:        hostName,
 		Description: hostName,
-		OsType:      "Linux",
+		TenantID:    tenantID,
+	})
+}
+
+// CreateHostWithOptions Create a new Host with the given options and registers the given initiators with it.
+// If an initiator cannot be registered, the host is deleted and an error is returned.
+func (c *UnityClientImpl) CreateHostWithOptions(ctx context.Context, opts *CreateHostOptions) (*types.Host, error) {
+	log := c.log(ctx)
+	if opts == nil || len(opts.Name) == 0 {
+		return nil, errors.New("hostname shouldn't be empty")
+	}
+	osType := opts.OsType
+	if osType == "" {
+		osType = LinuxHostOsType
+	}
+	hostReq := &types.HostCreateParam{
+		Type:        "1", // Host type hardcoded as "1" for manually created host
+		Name:        opts.Name,
+		Description: opts.Description,
+		OsType:      string(osType),
 	}
 
-	if tenantID != "" {
-		hostReq.Tenant = &tenantIDStruct
+	if opts.TenantID != "" {
+		hostReq.Tenant = &types.Tenants{
+			TenantID: opts.TenantID,
+		}
 	}
 
 	hostResp := &types.Host{}
@@ -71,10 +137,94 @@ func (c *UnityClientImpl) CreateHost(ctx context.Context, hostName string, tenan
 	if err != nil {
 		return nil, err
 	}
+
+	hostID := hostResp.HostContent.ID
+	for _, initiator := range opts.Initiators {
+		log.Debugf("Adding Initiator: %s to host: %s", initiator.WwnOrIqn, hostID)
+		_, err = c.CreateHostInitiator(ctx, hostID, initiator.WwnOrIqn, initiator.InitiatorType)
+		if err != nil {
+			// Do not leave a host without its initiators on the array
+			deleteErr := c.executeWithRetryAuthenticate(ctx, http.MethodDelete, fmt.Sprintf(api.UnityAPIGetResourceURI, api.HostAction, hostID), nil, nil)
+			if deleteErr != nil {
+				return nil, fmt.Errorf("adding initiator %s failed: %v, and deleting the created host %s failed: %v", initiator.WwnOrIqn, err, hostID, deleteErr)
+			}
+			return nil, fmt.Errorf("adding initiator %s failed, the created host %s was deleted: %v", initiator.WwnOrIqn, hostID, err)
+		}
+	}
 	return hostResp, nil
 }
 
-// DeleteHost function is used only in unit tests
+// ModifyHost modifies the name, description or OS type of the Host
+func (c *UnityClientImpl) ModifyHost(ctx context.Context, hostID string, opts *ModifyHostOptions) error {
+	if len(hostID) == 0 {
+		return errors.New("host ID shouldn't be empty")
+	}
+	if opts == nil {
+		return errors.New("modify host options shouldn't be nil")
+	}
+	hostReq := &types.HostModifyParam{
+		Name:        opts.Name,
+		Description: opts.Description,
+		OsType:      string(opts.OsType),
+	}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyHostURI, hostID), hostReq, nil)
+	if err != nil {
+		return fmt.Errorf("unable to modify host %s Error: %v", hostID, err)
+	}
+	return nil
+}
+
+// ListHosts lists the hosts matching the given filter. A nil filter lists all hosts.
+func (c *UnityClientImpl) ListHosts(ctx context.Context, filter *HostFilter) ([]types.Host, error) {
+	var clauses []string
+	if filter != nil {
+		if filter.Name != "" {
+			clauses = append(clauses, "name eq "+quoteFilterValue(filter.Name))
+		}
+		if filter.OsType != "" {
+			clauses = append(clauses, "osType eq "+quoteFilterValue(string(filter.OsType)))
+		}
+		if filter.TenantID != "" {
+			clauses = append(clauses, "tenant.id eq "+quoteFilterValue(filter.TenantID))
+		}
+	}
+
+	var hosts []types.Host
+	for page := 1; ; page++ {
+		result := &types.ListHost{}
+		err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, pagedListURI(api.HostAction, clauses, HostfieldsToQuery, page), nil, result)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list hosts: %v", err)
+		}
+		hosts = append(hosts, result.Hosts...)
+		if len(result.Hosts) == 0 || !hasNextPage(result.Links) {
+			return hosts, nil
+		}
+	}
+}
+
+// ListHostLUNs lists the LUNs and snapshots attached to the given Host along with their HLU, from all the pages
+func (c *UnityClientImpl) ListHostLUNs(ctx context.Context, hostID string) ([]types.HostLUN, error) {
+	if len(hostID) == 0 {
+		return nil, errors.New("host ID shouldn't be empty")
+	}
+	clauses := []string{"host.id eq " + quoteFilterValue(hostID)}
+
+	var hostLUNs []types.HostLUN
+	for page := 1; ; page++ {
+		result := &types.ListHostLUN{}
+		err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, pagedListURI(api.HostLUNAction, clauses, HostLUNDisplayFields, page), nil, result)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list host LUNs of host %s: %v", hostID, err)
+		}
+		hostLUNs = append(hostLUNs, result.HostLUNs...)
+		if len(result.HostLUNs) == 0 || !hasNextPage(result.Links) {
+			return hostLUNs, nil
+		}
+	}
+}
+
+// DeleteHost deletes the Host by its name
 func (c *UnityClientImpl) DeleteHost(ctx context.Context, hostName string) error {
 	if len(hostName) == 0 {
 		return fmt.Errorf("hostname shouldn't be empty")
@@ -88,6 +238,34 @@ func (c *UnityClientImpl) DeleteHost(ctx context.Context, hostName string) error
 	return nil
 }
 
+// DeleteHostByID deletes the Host by its ID. If any LUN or snapshot is still attached to the host,
+// ErrorHostHasAttachedLUNs is returned and the host is not deleted.
+func (c *UnityClientImpl) DeleteHostByID(ctx context.Context, hostID string) error {
+	log := c.log(ctx)
+	if len(hostID) == 0 {
+		return errors.New("host ID shouldn't be empty")
+	}
+
+	hostLUNs, err := c.ListHostLUNs(ctx, hostID)
+	if err != nil {
+		return err
+	}
+	if len(hostLUNs) > 0 {
+		log.Debugf("Host %s has %d attached host LUNs", hostID, len(hostLUNs))
+		return ErrorHostHasAttachedLUNs
+	}
+
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodDelete, fmt.Sprintf(api.UnityAPIGetResourceURI, api.HostAction, hostID), nil, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), HostNotFoundErrorCode) {
+			return ErrorHostNotFound
+		}
+		return fmt.Errorf("delete host %s failed: %v", hostID, err)
+	}
+	log.Debugf("Delete Host %s Successful", hostID)
+	return nil
+}
+
 // CreateHostIPPort - Create Host IP Port
 func (c *UnityClientImpl) CreateHostIPPort(ctx context.Context, hostID, ip string) (*types.HostIPPort, error) {
 	if len(hostID) == 0 {
@@ -132,32 +310,86 @@ func (c *UnityClientImpl) ListHostInitiators(ctx context.Context) ([]types.HostI
 	return listInitiatorResp.HostInitiator, nil
 }
 
-// FindHostInitiatorByName - Find Host Initiator by name
+// initiatorIndexTTL is how long the host initiator index is reused for lookups on arrays which do not support
+// filtering host initiators by initiatorId
+const initiatorIndexTTL = 5 * time.Minute
+
+// initiatorIndex caches the host initiator IDs keyed by their normalized WWN or IQN. It is only used on arrays
+// whose OE release does not support the initiatorId filter.
+type initiatorIndex struct {
+	sync.Mutex
+	initiators map[string]string
+	builtAt    time.Time
+}
+
+// invalidate drops the cached host initiators so that the next lookup rebuilds the index
+func (idx *initiatorIndex) invalidate() {
+	idx.Lock()
+	defer idx.Unlock()
+	idx.initiators = nil
+}
+
+// FindHostInitiatorByName - Find Host Initiator by its WWN or IQN. The lookup is filtered on the array, WWNs with or
+// without colons and IQNs in any case are matched. On OE releases without InitiatorIDFilterCapability, or when the
+// filter finds nothing because the array stores the initiator ID in another form, a cached index of all host
+// initiators is used instead.
 func (c *UnityClientImpl) FindHostInitiatorByName(ctx context.Context, wwnOrIqn string) (*types.HostInitiator, error) {
 	if len(wwnOrIqn) == 0 {
 		return nil, errors.New("host Initiator Name shouldn't be empty")
 	}
+	initiatorID := util.NormalizeInitiatorID(wwnOrIqn)
 
-	list, err := c.ListHostInitiators(ctx)
+	if !c.Capabilities().Supports(InitiatorIDFilterCapability) {
+		return c.findHostInitiatorInIndex(ctx, initiatorID)
+	}
+
+	filter := "initiatorId eq " + quoteFilterValue(initiatorID)
+	hostInitiatorURI := fmt.Sprintf(api.UnityInstancesFilterWithFields, api.HostInitiatorAction, url.QueryEscape(filter), HostInitiatorsDisplayFields)
+	listInitiatorResp := &types.ListHostInitiator{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, hostInitiatorURI, nil, listInitiatorResp)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, i := range list {
-		if strings.ToLower(i.HostInitiatorContent.InitiatorID) == strings.ToLower(wwnOrIqn) {
+	for _, i := range listInitiatorResp.HostInitiator {
+		if util.NormalizeInitiatorID(i.HostInitiatorContent.InitiatorID) == initiatorID {
 			return &i, nil
 		}
 	}
+	// The filter is an exact match, an IQN registered with upper case letters is only found in the index
+	return c.findHostInitiatorInIndex(ctx, initiatorID)
+}
 
-	// @TODO The following code should work. Unity rest api having a bug querying host initiators by host initiatorID
-	//hostInitiatorResp := &types.HostInitiator{}
-	//err := c.executeWithRetryAuthenticate(http.MethodGet, fmt.Sprintf(api.UnityApiGetResourceByPropertyWithFieldsUri, "hostInitiator", "initiatorID" ,wwnOrIqn, api.HostInitiatorsDisplayFields), nil, hostInitiatorResp)
-	//if err != nil {
-	//	log.Info("Unable to find host initiator:", wwnOrIqn)
-	//	return nil, errors.New(fmt.Sprintf("Unable to find host %s", wwnOrIqn))
-	//}
+// findHostInitiatorInIndex looks up the normalized initiator ID in the host initiator index, rebuilding it when expired.
+// The initiator found is fetched again so that a host change made by another client is not missed.
+func (c *UnityClientImpl) findHostInitiatorInIndex(ctx context.Context, initiatorID string) (*types.HostInitiator, error) {
+	c.initiatorIndex.Lock()
+	if c.initiatorIndex.initiators == nil || time.Since(c.initiatorIndex.builtAt) > initiatorIndexTTL {
+		list, err := c.ListHostInitiators(ctx)
+		if err != nil {
+			c.initiatorIndex.Unlock()
+			return nil, err
+		}
+		initiators := make(map[string]string, len(list))
+		for _, i := range list {
+			initiators[util.NormalizeInitiatorID(i.HostInitiatorContent.InitiatorID)] = i.HostInitiatorContent.ID
+		}
+		c.initiatorIndex.initiators = initiators
+		c.initiatorIndex.builtAt = time.Now()
+	}
+	id, ok := c.initiatorIndex.initiators[initiatorID]
+	c.initiatorIndex.Unlock()
+	if !ok {
+		return nil, errors.New("wwn or iqn not found")
+	}
 
-	return nil, errors.New("wwn or iqn not found")
+	initiator, err := c.FindHostInitiatorByID(ctx, id)
+	if err != nil {
+		// The initiator may have been deleted since the index was built
+		c.initiatorIndex.invalidate()
+		return nil, err
+	}
+	return initiator, nil
 }
 
 // FindHostInitiatorByID - Find Host Initiator
@@ -172,7 +404,7 @@ func (c *UnityClientImpl) FindHostInitiatorByID(ctx context.Context, wwnOrIqn st
 
 // CreateHostInitiator - Create Host Initiator
 func (c *UnityClientImpl) CreateHostInitiator(ctx context.Context, hostID, wwnOrIqn string, initiatorType types.InitiatorType) (*types.HostInitiator, error) {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	if len(hostID) == 0 {
 		return nil, errors.New("host ID shouldn't be empty")
 	}
@@ -201,6 +433,7 @@ func (c *UnityClientImpl) CreateHostInitiator(ctx context.Context, hostID, wwnOr
 		if err != nil {
 			return nil, fmt.Errorf("create Host Initiator %s Error: %v", wwnOrIqn, err)
 		}
+		c.initiatorIndex.invalidate()
 	} else if initiator.HostInitiatorContent.ParentHost.ID == "" {
 		log.Debugf("Initiator found, but parent host is not added. Updating the existing Initiator: %s to host: %s \n", wwnOrIqn, hostID)
 		initiator, err = c.ModifyHostInitiator(ctx, hostID, initiator)
@@ -248,9 +481,145 @@ func (c *UnityClientImpl) ModifyHostInitiatorByID(ctx context.Context, hostID, i
 	if err != nil {
 		return nil, err
 	}
+	c.initiatorIndex.invalidate()
 	return hostInitiatorResp, nil
 }
 
+// CHAP secret length constraints enforced by Unity
+const (
+	CHAPSecretMinLength = 12
+	CHAPSecretMaxLength = 16
+)
+
+// CHAPSecretType is int
+type CHAPSecretType int
+
+// CHAPSecretType constants
+const (
+	LibraryCHAPSecretType = CHAPSecretType(0)
+)
+
+// iscsiSettingsID is the ID of the single iscsiSettings instance on the array
+const iscsiSettingsID = "0"
+
+// InitiatorCHAP holds the one-way CHAP credentials of an iSCSI initiator
+type InitiatorCHAP struct {
+	Username string
+	Secret   string
+}
+
+// validate checks the CHAP credentials without ever including the secrets in the returned error
+func (chap *InitiatorCHAP) validate() error {
+	return validateCHAP("CHAP", chap.Username, chap.Secret)
+}
+
+// validateCHAP checks CHAP credentials without ever including the secret in the returned error
+func validateCHAP(kind, username, secret string) error {
+	if username == "" || secret == "" {
+		return fmt.Errorf("%s username and secret shouldn't be empty", kind)
+	}
+	if len(secret) < CHAPSecretMinLength || len(secret) > CHAPSecretMaxLength {
+		return fmt.Errorf("%s secret should be %d to %d characters long", kind, CHAPSecretMinLength, CHAPSecretMaxLength)
+	}
+	return nil
+}
+
+// DeleteHostInitiator deletes the host initiator by its ID
+func (c *UnityClientImpl) DeleteHostInitiator(ctx context.Context, initiatorID string) error {
+	log := c.log(ctx)
+	if initiatorID == "" {
+		return errors.New("Initiator ID shouldn't be null")
+	}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodDelete, fmt.Sprintf(api.UnityAPIGetResourceURI, api.HostInitiatorAction, initiatorID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("delete Host Initiator %s Error: %v", initiatorID, err)
+	}
+	c.initiatorIndex.invalidate()
+	log.Debugf("Delete Host Initiator %s Successful", initiatorID)
+	return nil
+}
+
+// SetInitiatorIgnored sets whether the array ignores the host initiator
+func (c *UnityClientImpl) SetInitiatorIgnored(ctx context.Context, initiatorID string, ignored bool) error {
+	if initiatorID == "" {
+		return errors.New("Initiator ID shouldn't be null")
+	}
+	hostInitiatorReq := &types.HostInitiatorModifyParam{
+		IsIgnored: &ignored,
+	}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyHostInitiators, initiatorID), hostInitiatorReq, nil)
+	if err != nil {
+		return fmt.Errorf("modify Host Initiator %s Error: %v", initiatorID, err)
+	}
+	c.initiatorIndex.invalidate()
+	return nil
+}
+
+// SetInitiatorCHAP configures one-way CHAP on the iSCSI host initiator, see SetMutualCHAP for the array wide
+// reverse credentials of mutual CHAP. Whether CHAP is required for all the initiators of the array is left unchanged.
+// A nil chap clears the initiator CHAP credentials. The secrets are never logged nor returned in errors.
+func (c *UnityClientImpl) SetInitiatorCHAP(ctx context.Context, initiatorID string, chap *InitiatorCHAP) error {
+	log := c.log(ctx)
+	if initiatorID == "" {
+		return errors.New("Initiator ID shouldn't be null")
+	}
+
+	chapReq := types.HostInitiatorCHAPModifyParam{
+		ChapSecretType: int(LibraryCHAPSecretType),
+	}
+	if chap != nil {
+		if err := chap.validate(); err != nil {
+			return err
+		}
+		chapReq.ChapUserName = chap.Username
+		chapReq.ChapSecret = chap.Secret
+	}
+
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyHostInitiators, initiatorID), chapReq, nil)
+	if err != nil {
+		return fmt.Errorf("unable to set CHAP on Host Initiator %s Error: %v", initiatorID, err)
+	}
+	log.Debugf("CHAP updated on Host Initiator %s", initiatorID)
+	return nil
+}
+
+// SetMutualCHAP sets the reverse CHAP credentials of the array, with which it authenticates to the initiators using
+// mutual CHAP. They are array wide, so every initiator configured for mutual CHAP is affected.
+// The secret is never logged nor returned in errors.
+func (c *UnityClientImpl) SetMutualCHAP(ctx context.Context, username, secret string) error {
+	log := c.log(ctx)
+	if err := validateCHAP("mutual CHAP", username, secret); err != nil {
+		return err
+	}
+	iscsiSettingsReq := types.IscsiSettingsModifyParam{
+		ReverseCHAPUserName: username,
+		ReverseCHAPSecret:   secret,
+	}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyIscsiSettingsURI, iscsiSettingsID), iscsiSettingsReq, nil)
+	if err != nil {
+		return fmt.Errorf("unable to set mutual CHAP Error: %v", err)
+	}
+	log.Debug("Mutual CHAP updated on iSCSI settings")
+	return nil
+}
+
+// ListInitiatorPaths lists the paths of the host initiator resolved to the FC port or iSCSI portal they use,
+// along with their login state
+func (c *UnityClientImpl) ListInitiatorPaths(ctx context.Context, initiatorID string) ([]types.HostInitiatorPath, error) {
+	if initiatorID == "" {
+		return nil, errors.New("Initiator ID shouldn't be null")
+	}
+	filter := "initiator.id eq " + quoteFilterValue(initiatorID)
+	pathURI := fmt.Sprintf(api.UnityInstancesFilterWithFields, api.HostInitiatorPathAction, url.QueryEscape(filter), HostInitiatorPathListFields)
+
+	listPathResp := &types.ListHostInitiatorPath{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, pathURI, nil, listPathResp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list paths of host initiator %s : %v", initiatorID, err)
+	}
+	return listPathResp.HostInitiatorPaths, nil
+}
+
 // FindHostInitiatorPathByID Finds Host Initiator
 func (c *UnityClientImpl) FindHostInitiatorPathByID(ctx context.Context, initiatorPathID string) (*types.HostInitiatorPath, error) {
 	hostInitiatorPathResp := &types.HostInitiatorPath{}
@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/dell/gounity/api"
@@ -172,6 +174,10 @@ func TestFindHostIPPortByID(t *testing.T) {
 	fmt.Println("Find Host IP Port Test Successful")
 }
 
+func isHostInitiatorFilterURI(uri string) bool {
+	return strings.HasPrefix(uri, "/api/types/hostInitiator/instances?filter=initiatorId+eq+")
+}
+
 func TestCreateHostInitiator(t *testing.T) {
 	fmt.Println("Begin - Create Host Initiator Test")
 
@@ -186,7 +192,9 @@ func TestCreateHostInitiator(t *testing.T) {
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", "/api/instances/hostIPPort/"+hostIPPortID+"?fields=id,address", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 
 	// Mock setup for host initiator retrieval
-	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", "/api/types/hostInitiator/instances?fields=id,health,type,initiatorId,isIgnored,parentHost,paths", mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(4)
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", mock.MatchedBy(isHostInitiatorFilterURI), mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(4)
+	testConf.client.(*UnityClientImpl).initiatorIndex.invalidate()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", api.UnityListHostInitiatorsURI+HostInitiatorsDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	// Mock setup for host initiator creation
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/types/hostInitiator/instances", mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(len(testConf.wwns) + 1)
@@ -296,9 +304,124 @@ func TestFindHostInitiatorByName(t *testing.T) {
 	_, err = testConf.client.FindHostInitiatorByName(ctx, "id")
 	assert.Nil(t, err)
 
+	// WWN without colons is looked up in its canonical form
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	filterURI := "/api/types/hostInitiator/instances?filter=initiatorId+eq+%2220%3A00%3A00%3A90%3AFA%3A53%3A4B%3A1A%3A10%3A00%3A00%3A90%3AFA%3A53%3A4B%3A1A%22&fields=" + HostInitiatorsDisplayFields
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", filterURI, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListHostInitiator)
+		resp.HostInitiator = []types.HostInitiator{{HostInitiatorContent: types.HostInitiatorContent{ID: "HostInitiator_1", InitiatorID: "20:00:00:90:FA:53:4B:1A:10:00:00:90:FA:53:4B:1A"}}}
+	}).Once()
+	initiator, err := testConf.client.FindHostInitiatorByName(ctx, "20000090fa534b1a10000090fa534b1a")
+	assert.NoError(t, err)
+	assert.Equal(t, "HostInitiator_1", initiator.HostInitiatorContent.ID)
+
+	// Initiator not found by the filter nor in the index
+	testConf.client.(*UnityClientImpl).initiatorIndex.invalidate()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", mock.MatchedBy(isHostInitiatorFilterURI), mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", api.UnityListHostInitiatorsURI+HostInitiatorsDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	_, err = testConf.client.FindHostInitiatorByName(ctx, testConf.iqn)
+	assert.Equal(t, errors.New("wwn or iqn not found"), err)
+
 	fmt.Println("FindHostInitiatorByName Test Successful")
 }
 
+func TestFindHostInitiatorByNameWithoutFilterSupport(t *testing.T) {
+	fmt.Println("Begin - FindHostInitiatorByName without filter support")
+	client := &UnityClientImpl{
+		api:           &mocksapi.Client{},
+		configConnect: &ConfigConnect{},
+	}
+	client.capabilities.Store(&Capabilities{SoftwareVersion: "4.5.1.0.5.001"})
+	ctx := context.Background()
+	listURI := api.UnityListHostInitiatorsURI + HostInitiatorsDisplayFields
+	initiatorURI := func(id string) string {
+		return fmt.Sprintf(api.UnityAPIGetResourceWithFieldsURI, api.HostInitiatorAction, id, HostInitiatorsDisplayFields)
+	}
+
+	client.api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", listURI, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListHostInitiator)
+		resp.HostInitiator = []types.HostInitiator{
+			{HostInitiatorContent: types.HostInitiatorContent{ID: "HostInitiator_1", InitiatorID: "iqn.1996-04.de.suse:01:f8298e544dc"}},
+			{HostInitiatorContent: types.HostInitiatorContent{ID: "HostInitiator_2", InitiatorID: "20:00:00:90:FA:53:4B:1A:10:00:00:90:FA:53:4B:1A"}},
+		}
+	}).Once()
+	client.api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", initiatorURI("HostInitiator_1"), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.HostInitiator)
+		resp.HostInitiatorContent.ID = "HostInitiator_1"
+	}).Once()
+
+	initiator, err := client.FindHostInitiatorByName(ctx, "IQN.1996-04.DE.SUSE:01:F8298E544DC")
+	assert.NoError(t, err)
+	assert.Equal(t, "HostInitiator_1", initiator.HostInitiatorContent.ID)
+
+	// Served from the index, the initiator is fetched again to return its current host
+	client.api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", initiatorURI("HostInitiator_2"), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.HostInitiator)
+		resp.HostInitiatorContent.ID = "HostInitiator_2"
+		resp.HostInitiatorContent.ParentHost.ID = "Host_2"
+	}).Once()
+	initiator, err = client.FindHostInitiatorByName(ctx, "20000090FA534B1A10000090FA534B1A")
+	assert.NoError(t, err)
+	assert.Equal(t, "Host_2", initiator.HostInitiatorContent.ParentHost.ID)
+
+	_, err = client.FindHostInitiatorByName(ctx, "iqn.unknown")
+	assert.Equal(t, errors.New("wwn or iqn not found"), err)
+
+	// Index is rebuilt after an initiator is deleted
+	client.api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "DELETE", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err = client.DeleteHostInitiator(ctx, "HostInitiator_1")
+	assert.NoError(t, err)
+	client.api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", listURI, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("list host initiators failed")).Once()
+	_, err = client.FindHostInitiatorByName(ctx, "iqn.1996-04.de.suse:01:f8298e544dc")
+	assert.Error(t, err)
+
+	client.api.(*mocksapi.Client).AssertExpectations(t)
+	client.api.(*mocksapi.Client).AssertNotCalled(t, "DoWithHeaders", mock.Anything, "GET", mock.MatchedBy(isHostInitiatorFilterURI), mock.Anything, mock.Anything, mock.Anything)
+	fmt.Println("FindHostInitiatorByName without filter support Test Successful")
+}
+
+func TestFindHostInitiatorByNameFilterError(t *testing.T) {
+	fmt.Println("Begin - FindHostInitiatorByName filter error")
+	client := &UnityClientImpl{
+		api:           &mocksapi.Client{},
+		configConnect: &ConfigConnect{},
+	}
+	client.capabilities.Store(&Capabilities{SoftwareVersion: "5.4.0.0.5.094"})
+	ctx := context.Background()
+
+	// A rejected filter is returned as is and does not switch the client to the index
+	client.api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", mock.MatchedBy(isHostInitiatorFilterURI), mock.Anything, mock.Anything, mock.Anything).Return(&types.Error{
+		ErrorContent: types.ErrorContent{HTTPStatusCode: http.StatusUnprocessableEntity},
+	}).Once()
+	_, err := client.FindHostInitiatorByName(ctx, "iqn.bad\"input")
+	assert.Error(t, err)
+
+	client.api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", mock.MatchedBy(isHostInitiatorFilterURI), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListHostInitiator)
+		resp.HostInitiator = []types.HostInitiator{{HostInitiatorContent: types.HostInitiatorContent{ID: "HostInitiator_1", InitiatorID: "iqn.1996-04.de.suse:01:f8298e544dc"}}}
+	}).Once()
+	initiator, err := client.FindHostInitiatorByName(ctx, "iqn.1996-04.de.suse:01:f8298e544dc")
+	assert.NoError(t, err)
+	assert.Equal(t, "HostInitiator_1", initiator.HostInitiatorContent.ID)
+
+	// An IQN registered in upper case is missed by the exact filter and found in the index
+	client.api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", mock.MatchedBy(isHostInitiatorFilterURI), mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	client.api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", api.UnityListHostInitiatorsURI+HostInitiatorsDisplayFields, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListHostInitiator)
+		resp.HostInitiator = []types.HostInitiator{{HostInitiatorContent: types.HostInitiatorContent{ID: "HostInitiator_2", InitiatorID: "IQN.1996-04.DE.SUSE:01:ABCDEF"}}}
+	}).Once()
+	client.api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf(api.UnityAPIGetResourceWithFieldsURI, api.HostInitiatorAction, "HostInitiator_2", HostInitiatorsDisplayFields), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.HostInitiator)
+		resp.HostInitiatorContent.ID = "HostInitiator_2"
+	}).Once()
+	initiator, err = client.FindHostInitiatorByName(ctx, "iqn.1996-04.de.suse:01:abcdef")
+	assert.NoError(t, err)
+	assert.Equal(t, "HostInitiator_2", initiator.HostInitiatorContent.ID)
+
+	client.api.(*mocksapi.Client).AssertExpectations(t)
+	fmt.Println("FindHostInitiatorByName filter error Test Successful")
+}
+
 func TestModifyHostInitiator(t *testing.T) {
 	fmt.Println("Begin - Modify Host Initiator Test")
 	ctx := context.Background()
@@ -442,3 +565,324 @@ func TestFindHostInitiatorByID(t *testing.T) {
 	_, err = testConf.client.FindHostInitiatorByID(ctx, "")
 	assert.Error(t, err)
 }
+
+func TestCreateHostWithOptions(t *testing.T) {
+	fmt.Println("Begin - Create Host With Options Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	_, err := testConf.client.CreateHostWithOptions(ctx, nil)
+	assert.Equal(t, errors.New("hostname shouldn't be empty"), err)
+
+	opts := &CreateHostOptions{
+		Name:        "valid_host_name",
+		Description: "node host",
+		OsType:      ESXiHostOsType,
+		TenantID:    testConf.tenant,
+	}
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/types/host/instances", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		req := args.Get(4).(*types.HostCreateParam)
+		assert.Equal(t, "VMware ESXi", req.OsType)
+		assert.Equal(t, testConf.tenant, req.Tenant.TenantID)
+		resp := args.Get(5).(*types.Host)
+		resp.HostContent.ID = "Host_1"
+	}).Once()
+	host, err := testConf.client.CreateHostWithOptions(ctx, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "Host_1", host.HostContent.ID)
+
+	// Initiator registration failure after the host is created
+	opts.Initiators = []HostInitiatorSpec{{WwnOrIqn: testConf.iqn, InitiatorType: api.ISCSCIInitiatorType}}
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/types/host/instances", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.Host)
+		resp.HostContent.ID = "Host_1"
+	}).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("list initiators failed")).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("create initiator failed")).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "DELETE", "/api/instances/host/Host_1", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	host, err = testConf.client.CreateHostWithOptions(ctx, opts)
+	assert.ErrorContains(t, err, "the created host Host_1 was deleted")
+	assert.Nil(t, host)
+
+	// The created host cannot be deleted either
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/types/host/instances", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.Host)
+		resp.HostContent.ID = "Host_1"
+	}).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("list initiators failed")).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("create initiator failed")).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("delete host failed")).Once()
+	host, err = testConf.client.CreateHostWithOptions(ctx, opts)
+	assert.ErrorContains(t, err, "deleting the created host Host_1 failed")
+	assert.Nil(t, host)
+
+	fmt.Println("Create Host With Options Test Successful")
+}
+
+func TestModifyHost(t *testing.T) {
+	fmt.Println("Begin - Modify Host Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	err := testConf.client.ModifyHost(ctx, "", &ModifyHostOptions{})
+	assert.Equal(t, errors.New("host ID shouldn't be empty"), err)
+
+	err = testConf.client.ModifyHost(ctx, "Host_1", nil)
+	assert.Equal(t, errors.New("modify host options shouldn't be nil"), err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/instances/host/Host_1/action/modify", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err = testConf.client.ModifyHost(ctx, "Host_1", &ModifyHostOptions{Description: "decommissioned"})
+	assert.NoError(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("modify host failed")).Once()
+	err = testConf.client.ModifyHost(ctx, "Host_1", &ModifyHostOptions{Name: "new-name"})
+	assert.Error(t, err)
+
+	fmt.Println("Modify Host Test Successful")
+}
+
+func TestListHosts(t *testing.T) {
+	fmt.Println("Begin - List Hosts Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	// The hosts of all the pages are returned
+	listURI := "/api/types/host/instances?fields=" + HostfieldsToQuery + "&per_page=1000&page=%d"
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf(listURI, 1), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListHost)
+		resp.Hosts = []types.Host{{HostContent: types.HostContent{ID: "Host_1"}}, {HostContent: types.HostContent{ID: "Host_2"}}}
+		resp.Links = []types.Link{{Rel: "next"}}
+	}).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf(listURI, 2), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListHost)
+		resp.Hosts = []types.Host{{HostContent: types.HostContent{ID: "Host_3"}}}
+	}).Once()
+	hosts, err := testConf.client.ListHosts(ctx, nil)
+	assert.NoError(t, err)
+	assert.Len(t, hosts, 3)
+
+	filterURI := "/api/types/host/instances?filter=name+eq+%22node-1%5C%22+or+id+eq+%5C%22Host_1%22+and+tenant.id+eq+%22tenant_1%22&fields=" + HostfieldsToQuery + "&per_page=1000&page=1"
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", filterURI, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	_, err = testConf.client.ListHosts(ctx, &HostFilter{Name: `node-1" or id eq "Host_1`, TenantID: "tenant_1"})
+	assert.NoError(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("list hosts failed")).Once()
+	_, err = testConf.client.ListHosts(ctx, &HostFilter{OsType: LinuxHostOsType})
+	assert.Error(t, err)
+
+	fmt.Println("List Hosts Test Successful")
+}
+
+func TestListHostLUNs(t *testing.T) {
+	fmt.Println("Begin - List Host LUNs Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	_, err := testConf.client.ListHostLUNs(ctx, "")
+	assert.Equal(t, errors.New("host ID shouldn't be empty"), err)
+
+	hostLUNURI := func(page int) string {
+		return fmt.Sprintf("/api/types/hostLUN/instances?filter=host.id+eq+%%22Host_1%%22&fields=%s&per_page=1000&page=%d", HostLUNDisplayFields, page)
+	}
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", hostLUNURI(1), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListHostLUN)
+		resp.HostLUNs = []types.HostLUN{{HostLUNContent: types.HostLUNContent{ID: "Host_1_sv_1_prod", HLU: 1, Lun: types.StorageResource{ID: "sv_1"}}}}
+		resp.Links = []types.Link{{Rel: "next"}}
+	}).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", hostLUNURI(2), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListHostLUN)
+		resp.HostLUNs = []types.HostLUN{{HostLUNContent: types.HostLUNContent{ID: "Host_1_sv_2_prod", HLU: 2, Lun: types.StorageResource{ID: "sv_2"}}}}
+	}).Once()
+	hostLUNs, err := testConf.client.ListHostLUNs(ctx, "Host_1")
+	assert.NoError(t, err)
+	assert.Len(t, hostLUNs, 2)
+	assert.Equal(t, 1, hostLUNs[0].HostLUNContent.HLU)
+	assert.Equal(t, "sv_1", hostLUNs[0].HostLUNContent.Lun.ID)
+	assert.Equal(t, "sv_2", hostLUNs[1].HostLUNContent.Lun.ID)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("list host LUNs failed")).Once()
+	_, err = testConf.client.ListHostLUNs(ctx, "Host_1")
+	assert.Error(t, err)
+
+	fmt.Println("List Host LUNs Test Successful")
+}
+
+func TestDeleteHostByID(t *testing.T) {
+	fmt.Println("Begin - Delete Host By ID Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	err := testConf.client.DeleteHostByID(ctx, "")
+	assert.Equal(t, errors.New("host ID shouldn't be empty"), err)
+
+	// Host with attached LUNs
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListHostLUN)
+		resp.HostLUNs = []types.HostLUN{{HostLUNContent: types.HostLUNContent{ID: "Host_1_sv_1_prod"}}}
+	}).Once()
+	err = testConf.client.DeleteHostByID(ctx, "Host_1")
+	assert.Equal(t, ErrorHostHasAttachedLUNs, err)
+
+	// Host without attached LUNs
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "DELETE", "/api/instances/host/Host_1", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err = testConf.client.DeleteHostByID(ctx, "Host_1")
+	assert.NoError(t, err)
+
+	// Host not found
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "DELETE", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New(HostNotFoundErrorCode)).Once()
+	err = testConf.client.DeleteHostByID(ctx, "Host_1")
+	assert.Equal(t, ErrorHostNotFound, err)
+
+	// List host LUNs failure
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("list host LUNs failed")).Once()
+	err = testConf.client.DeleteHostByID(ctx, "Host_1")
+	assert.Error(t, err)
+
+	fmt.Println("Delete Host By ID Test Successful")
+}
+
+func TestDeleteHostInitiator(t *testing.T) {
+	fmt.Println("Begin - Delete Host Initiator Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	err := testConf.client.DeleteHostInitiator(ctx, "")
+	assert.Equal(t, errors.New("Initiator ID shouldn't be null"), err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "DELETE", "/api/instances/hostInitiator/HostInitiator_1", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err = testConf.client.DeleteHostInitiator(ctx, "HostInitiator_1")
+	assert.NoError(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("delete host initiator failed")).Once()
+	err = testConf.client.DeleteHostInitiator(ctx, "HostInitiator_1")
+	assert.Error(t, err)
+
+	fmt.Println("Delete Host Initiator Test Successful")
+}
+
+func TestSetInitiatorIgnored(t *testing.T) {
+	fmt.Println("Begin - Set Initiator Ignored Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	err := testConf.client.SetInitiatorIgnored(ctx, "", true)
+	assert.Equal(t, errors.New("Initiator ID shouldn't be null"), err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/instances/hostInitiator/HostInitiator_1/action/modify", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		req := args.Get(4).(*types.HostInitiatorModifyParam)
+		assert.Nil(t, req.HostIDContent)
+		assert.True(t, *req.IsIgnored)
+	}).Once()
+	err = testConf.client.SetInitiatorIgnored(ctx, "HostInitiator_1", true)
+	assert.NoError(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("modify host initiator failed")).Once()
+	err = testConf.client.SetInitiatorIgnored(ctx, "HostInitiator_1", false)
+	assert.Error(t, err)
+
+	fmt.Println("Set Initiator Ignored Test Successful")
+}
+
+func TestSetInitiatorCHAP(t *testing.T) {
+	fmt.Println("Begin - Set Initiator CHAP Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+	secret := "initiatorSecret1"
+
+	err := testConf.client.SetInitiatorCHAP(ctx, "", nil)
+	assert.Equal(t, errors.New("Initiator ID shouldn't be null"), err)
+
+	invalid := []*InitiatorCHAP{
+		{Username: "", Secret: secret},
+		{Username: "user", Secret: "short"},
+	}
+	for _, chap := range invalid {
+		err = testConf.client.SetInitiatorCHAP(ctx, "HostInitiator_1", chap)
+		assert.Error(t, err)
+		assert.NotContains(t, err.Error(), secret)
+	}
+
+	// One-way CHAP
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/instances/hostInitiator/HostInitiator_1/action/modify", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		req := args.Get(4).(types.HostInitiatorCHAPModifyParam)
+		assert.Equal(t, "user", req.ChapUserName)
+		assert.Equal(t, secret, req.ChapSecret)
+		assert.Equal(t, int(LibraryCHAPSecretType), req.ChapSecretType)
+	}).Once()
+	err = testConf.client.SetInitiatorCHAP(ctx, "HostInitiator_1", &InitiatorCHAP{Username: "user", Secret: secret})
+	assert.NoError(t, err)
+
+	// Clear CHAP
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/instances/hostInitiator/HostInitiator_1/action/modify", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		req := args.Get(4).(types.HostInitiatorCHAPModifyParam)
+		assert.Empty(t, req.ChapUserName)
+		assert.Empty(t, req.ChapSecret)
+	}).Once()
+	err = testConf.client.SetInitiatorCHAP(ctx, "HostInitiator_1", nil)
+	assert.NoError(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("modify host initiator failed")).Once()
+	err = testConf.client.SetInitiatorCHAP(ctx, "HostInitiator_1", &InitiatorCHAP{Username: "user", Secret: secret})
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), secret)
+
+	fmt.Println("Set Initiator CHAP Test Successful")
+}
+
+func TestSetMutualCHAP(t *testing.T) {
+	fmt.Println("Begin - Set Mutual CHAP Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+	secret := "targetSecret01"
+
+	for _, chap := range [][2]string{{"", secret}, {"target", ""}, {"target", "short"}} {
+		err := testConf.client.SetMutualCHAP(ctx, chap[0], chap[1])
+		assert.Error(t, err)
+		assert.NotContains(t, err.Error(), secret)
+	}
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/instances/iscsiSettings/0/action/modify", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		req := args.Get(4).(types.IscsiSettingsModifyParam)
+		assert.Equal(t, "target", req.ReverseCHAPUserName)
+		assert.Equal(t, secret, req.ReverseCHAPSecret)
+	}).Once()
+	assert.NoError(t, testConf.client.SetMutualCHAP(ctx, "target", secret))
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("modify iscsi settings failed")).Once()
+	err := testConf.client.SetMutualCHAP(ctx, "target", secret)
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), secret)
+
+	fmt.Println("Set Mutual CHAP Test Successful")
+}
+
+func TestListInitiatorPaths(t *testing.T) {
+	fmt.Println("Begin - List Initiator Paths Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+
+	_, err := testConf.client.ListInitiatorPaths(ctx, "")
+	assert.Equal(t, errors.New("Initiator ID shouldn't be null"), err)
+
+	pathURI := "/api/types/hostInitiatorPath/instances?filter=initiator.id+eq+%22HostInitiator_1%22&fields=" + HostInitiatorPathListFields
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", pathURI, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListHostInitiatorPath)
+		resp.HostInitiatorPaths = []types.HostInitiatorPath{
+			{HostInitiatorPathContent: types.HostInitiatorPathContent{ID: "HostInitiator_1_spa_iom_0_fc0", IsLoggedIn: true, FcPortID: types.FcPortID{ID: "spa_iom_0_fc0", Wwn: "50:06:01:60:C7:E0:01:DA"}}},
+			{HostInitiatorPathContent: types.HostInitiatorPathContent{ID: "HostInitiator_1_spb_iom_0_fc0", IsLoggedIn: false, FcPortID: types.FcPortID{ID: "spb_iom_0_fc0"}}},
+		}
+	}).Once()
+	paths, err := testConf.client.ListInitiatorPaths(ctx, "HostInitiator_1")
+	assert.NoError(t, err)
+	assert.Len(t, paths, 2)
+	assert.True(t, paths[0].HostInitiatorPathContent.IsLoggedIn)
+	assert.Equal(t, "50:06:01:60:C7:E0:01:DA", paths[0].HostInitiatorPathContent.FcPortID.Wwn)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("list paths failed")).Once()
+	_, err = testConf.client.ListInitiatorPaths(ctx, "HostInitiator_1")
+	assert.Error(t, err)
+
+	fmt.Println("List Initiator Paths Test Successful")
+}
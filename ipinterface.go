@@ -10,15 +10,13 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/dell/gounity/util"
-
 	"github.com/dell/gounity/api"
 	"github.com/dell/gounity/types"
 )
 
 // ListIscsiIPInterfaces - List the IpnInterfaces configured on the array
 func (c *UnityClientImpl) ListIscsiIPInterfaces(ctx context.Context) ([]types.IPInterfaceEntries, error) {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	hResponse := &types.ListIPInterfaces{}
 	log.Debugf("URI: "+api.UnityAPIInstanceTypeResourcesWithFields, api.IPInterface, IscsiIPFields)
 	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.IPInterface, IscsiIPFields), nil, hResponse)
@@ -0,0 +1,145 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/dell/gounity/api"
+	"github.com/dell/gounity/types"
+)
+
+// licenseCacheTTL is how long licenses and features are cached before being fetched again
+var licenseCacheTTL = 10 * time.Minute
+
+// licenseCache holds the licenses and features of the array, which rarely change
+type licenseCache struct {
+	sync.Mutex
+	licenses   map[LicenseType]cachedLicense
+	listedAt   time.Time
+	list       []types.LicenseInfo
+	features   []types.Feature
+	featuresAt time.Time
+}
+
+type cachedLicense struct {
+	license   types.LicenseInfo
+	fetchedAt time.Time
+}
+
+// ListLicenses lists the licenses of the array. The list is cached for licenseCacheTTL.
+func (c *UnityClientImpl) ListLicenses(ctx context.Context) ([]types.LicenseInfo, error) {
+	c.licenseCache.Lock()
+	if c.licenseCache.list != nil && time.Since(c.licenseCache.listedAt) < licenseCacheTTL {
+		list := slices.Clone(c.licenseCache.list)
+		c.licenseCache.Unlock()
+		return list, nil
+	}
+	c.licenseCache.Unlock()
+
+	// The cache is not locked during the request, so that the cached licenses are not held up by a slow array
+	licenses := &types.ListLicenseInfo{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.LicenseAction, LicenseInfoDisplayFields), nil, licenses)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list licenses: %v", err)
+	}
+	list := licenses.Entries
+	if list == nil {
+		list = []types.LicenseInfo{}
+	}
+
+	now := time.Now()
+	c.licenseCache.Lock()
+	defer c.licenseCache.Unlock()
+	if c.licenseCache.licenses == nil {
+		c.licenseCache.licenses = make(map[LicenseType]cachedLicense)
+	}
+	for _, license := range list {
+		c.licenseCache.licenses[LicenseType(license.LicenseInfoContent.Name)] = cachedLicense{license: license, fetchedAt: now}
+	}
+	c.licenseCache.list = slices.Clone(list)
+	c.licenseCache.listedAt = now
+	return list, nil
+}
+
+// GetLicense gets a license of the array by its name. The license is cached for licenseCacheTTL.
+func (c *UnityClientImpl) GetLicense(ctx context.Context, licenseName LicenseType) (*types.LicenseInfo, error) {
+	if licenseName == "" {
+		return nil, errors.New("license name shouldn't be empty")
+	}
+	c.licenseCache.Lock()
+	if cached, ok := c.licenseCache.licenses[licenseName]; ok && time.Since(cached.fetchedAt) < licenseCacheTTL {
+		license := cached.license
+		c.licenseCache.Unlock()
+		return &license, nil
+	}
+	c.licenseCache.Unlock()
+
+	license := &types.LicenseInfo{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIGetResourceByNameWithFieldsURI, api.LicenseAction, licenseName, LicenseInfoDisplayFields), nil, license)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get license %s: %v", licenseName, err)
+	}
+
+	c.licenseCache.Lock()
+	defer c.licenseCache.Unlock()
+	if c.licenseCache.licenses == nil {
+		c.licenseCache.licenses = make(map[LicenseType]cachedLicense)
+	}
+	c.licenseCache.licenses[licenseName] = cachedLicense{license: *license, fetchedAt: time.Now()}
+	return license, nil
+}
+
+// ListFeatures lists the features of the array. The list is cached for licenseCacheTTL.
+func (c *UnityClientImpl) ListFeatures(ctx context.Context) ([]types.Feature, error) {
+	if features, ok := c.cachedFeatures(); ok {
+		return features, nil
+	}
+
+	features := &types.ListFeature{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.FeatureAction, FeatureFields), nil, features)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list features: %v", err)
+	}
+	return c.cacheFeatures(features.Entries), nil
+}
+
+// cachedFeatures returns a copy of the cached features while they are fresh
+func (c *UnityClientImpl) cachedFeatures() ([]types.Feature, bool) {
+	c.licenseCache.Lock()
+	defer c.licenseCache.Unlock()
+	if c.licenseCache.features != nil && time.Since(c.licenseCache.featuresAt) < licenseCacheTTL {
+		return slices.Clone(c.licenseCache.features), true
+	}
+	return nil, false
+}
+
+// cacheFeatures stores a copy of the listed features in the cache and returns the list
+func (c *UnityClientImpl) cacheFeatures(list []types.Feature) []types.Feature {
+	if list == nil {
+		list = []types.Feature{}
+	}
+	c.licenseCache.Lock()
+	defer c.licenseCache.Unlock()
+	c.licenseCache.features = slices.Clone(list)
+	c.licenseCache.featuresAt = time.Now()
+	return list
+}
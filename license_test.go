@@ -0,0 +1,172 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/dell/gounity/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// enableLicenseCache turns the license cache on for the duration of a test, starting from an empty cache
+func enableLicenseCache(t *testing.T) {
+	client := testConf.client.(*UnityClientImpl)
+	reset := func() {
+		client.licenseCache.licenses, client.licenseCache.list, client.licenseCache.features = nil, nil, nil
+	}
+	reset()
+	ttl := licenseCacheTTL
+	licenseCacheTTL = time.Minute
+	t.Cleanup(func() {
+		licenseCacheTTL = ttl
+		reset()
+	})
+}
+
+func TestListLicenses(t *testing.T) {
+	fmt.Println("Begin - List Licenses Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+	enableLicenseCache(t)
+	ctx := context.Background()
+
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf("/api/types/license/instances?fields=%s", LicenseInfoDisplayFields), mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListLicenseInfo")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListLicenseInfo)
+		resp.Entries = []types.LicenseInfo{
+			{LicenseInfoContent: types.LicenseInfoContent{Name: string(ThinProvisioning), IsInstalled: true, IsValid: true, IsPermanent: true}},
+			{LicenseInfoContent: types.LicenseInfoContent{Name: string(DataReduction), IsInstalled: false}},
+		}
+	}).Once()
+	licenses, err := testConf.client.ListLicenses(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, licenses, 2)
+
+	// Served from the cache, including the individual licenses. Changing the returned list leaves the cache unchanged.
+	licenses[0].LicenseInfoContent.IsValid = false
+	licenses, err = testConf.client.ListLicenses(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, licenses, 2)
+	assert.True(t, licenses[0].LicenseInfoContent.IsValid)
+	license, err := testConf.client.GetLicense(ctx, ThinProvisioning)
+	assert.NoError(t, err)
+	assert.True(t, license.LicenseInfoContent.IsPermanent)
+
+	// Negative case
+	enableLicenseCache(t)
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListLicenseInfo")).Return(errors.New("error")).Once()
+	_, err = testConf.client.ListLicenses(ctx)
+	assert.Error(t, err)
+
+	fmt.Println("List Licenses Test Successful")
+}
+
+func TestGetLicense(t *testing.T) {
+	fmt.Println("Begin - Get License Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+	enableLicenseCache(t)
+	ctx := context.Background()
+
+	_, err := testConf.client.GetLicense(ctx, "")
+	assert.Error(t, err)
+
+	expires := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf("/api/instances/license/name:DATA_REDUCTION?fields=%s", LicenseInfoDisplayFields), mock.Anything, mock.Anything, mock.AnythingOfType("*types.LicenseInfo")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.LicenseInfo)
+		resp.LicenseInfoContent = types.LicenseInfoContent{Name: string(DataReduction), IsInstalled: true, IsValid: true, Expires: expires}
+	}).Once()
+	license, err := testConf.client.GetLicense(ctx, DataReduction)
+	assert.NoError(t, err)
+	assert.Equal(t, expires, license.LicenseInfoContent.Expires)
+
+	// The provisioning license checks are served from the cache
+	license, err = testConf.client.(*UnityClientImpl).isFeatureLicensed(ctx, DataReduction)
+	assert.NoError(t, err)
+	assert.True(t, license.LicenseInfoContent.IsValid)
+
+	// Negative case
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.LicenseInfo")).Return(errors.New("error")).Once()
+	_, err = testConf.client.GetLicense(ctx, ThinProvisioning)
+	assert.Error(t, err)
+
+	fmt.Println("Get License Test Successful")
+}
+
+func TestLicenseCacheNotLockedDuringRequest(t *testing.T) {
+	fmt.Println("Begin - License Cache Not Locked During Request Test")
+	enableLicenseCache(t)
+	mockClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: mockClient}
+	client.licenseCache.list = []types.LicenseInfo{{LicenseInfoContent: types.LicenseInfoContent{Name: string(ThinProvisioning)}}}
+	client.licenseCache.listedAt = time.Now()
+	ctx := context.Background()
+
+	started, release := make(chan struct{}), make(chan struct{})
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.LicenseInfo")).Return(nil).Run(func(_ mock.Arguments) {
+		close(started)
+		<-release
+	}).Once()
+	done := make(chan error)
+	go func() {
+		_, err := client.GetLicense(ctx, DataReduction)
+		done <- err
+	}()
+	<-started
+
+	// The cached list is served while the license is being fetched
+	licenses, err := client.ListLicenses(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, licenses, 1)
+	close(release)
+	assert.NoError(t, <-done)
+
+	fmt.Println("License Cache Not Locked During Request Test Successful")
+}
+
+func TestListFeatures(t *testing.T) {
+	fmt.Println("Begin - List Features Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+	enableLicenseCache(t)
+	ctx := context.Background()
+
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf("/api/types/feature/instances?fields=%s", FeatureFields), mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListFeature")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListFeature)
+		resp.Entries = []types.Feature{{FeatureContent: types.FeatureContent{ID: "SNAP", State: 2}}}
+	}).Once()
+	features, err := testConf.client.ListFeatures(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, features, 1)
+	features[0].FeatureContent.State = 0
+	features, err = testConf.client.ListFeatures(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, features, 1)
+	assert.Equal(t, 2, features[0].FeatureContent.State)
+
+	// Negative case
+	enableLicenseCache(t)
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListFeature")).Return(errors.New("error")).Once()
+	_, err = testConf.client.ListFeatures(ctx)
+	assert.Error(t, err)
+
+	fmt.Println("List Features Test Successful")
+}
@@ -82,6 +82,8 @@ func TestMain(m *testing.M) {
 	os.Setenv("X_CSI_UNITY_PASSWORD", testConf.password)
 
 	testConf.client = getTestClient()
+	// Mocked responses differ from test to test, so licenses are fetched on every call unless a test enables the cache
+	licenseCacheTTL = 0
 	testConf.wwns = strings.Split(wwnStr, ",")
 	testConf.hostList = strings.Split(hostListStr, ",")
 
@@ -13,49 +13,128 @@ package gounity
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/dell/gounity/api"
 	"github.com/dell/gounity/types"
-	"github.com/dell/gounity/util"
 )
 
-// GetAllRealTimeMetricPaths gets all the Unity Metric paths. Consider using for debugging
-// or enumerating metrics. This will take a bit of time to complete.
-// - /api/types/metric/instances?compact=true&filter=isRealtimeAvailable eq true
+// GetAllRealTimeMetricPaths gets all the Unity Metric paths available in real time and logs them. Consider using for debugging.
+//
+// Deprecated: Use ListMetrics, which returns the metrics instead of logging them.
 func (c *UnityClientImpl) GetAllRealTimeMetricPaths(ctx context.Context) error {
-	log := util.GetRunIDLogger(ctx)
-	filter := "isRealtimeAvailable eq true"
+	log := c.log(ctx)
+	metrics, err := c.ListMetrics(ctx, &MetricFilter{Realtime: true})
+	if err != nil {
+		return err
+	}
+	for _, metric := range metrics {
+		log.Debugf("%d - %s - %s", metric.ID, metric.Path, metric.Description)
+	}
+	return nil
+}
 
-	query := fmt.Sprintf("%s&compact=true", url.QueryEscape(filter))
-	queryURI := fmt.Sprintf(api.UnityInstancesFilter, api.UnityMetric, query)
-	log.Info("GetAllRealTimeMetricPaths: ", queryURI)
+// MetricFilter narrows down the metrics returned by ListMetrics
+type MetricFilter struct {
+	// Realtime only lists the metrics available in real time queries
+	Realtime bool
+	// Historical only lists the metrics available in historical queries
+	Historical bool
+	// PathPrefix only lists the metrics whose path starts with the prefix, e.g. "sp.*.storage.lun"
+	PathPrefix string
+}
 
-	result := &types.MetricPaths{}
+// metricCatalogPageSize is the number of metrics requested per page
+const metricCatalogPageSize = 2000
 
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, queryURI, nil, result)
-	if err != nil {
-		return err
+// metricCatalog caches the metrics of the array keyed by the filter used to list them. The metrics of an
+// array do not change while the client is connected to it.
+type metricCatalog struct {
+	sync.Mutex
+	metrics map[string][]types.MetricInfo
+}
+
+// ListMetrics lists the metrics of the array matching the filter. A nil filter lists all metrics.
+// - The catalog is fetched once per filter with a paged query and cached for the lifetime of the client.
+// - Example: GET /api/types/metric/instances?fields=id,name,path,...&filter=isRealtimeAvailable eq true&per_page=2000&page=1
+func (c *UnityClientImpl) ListMetrics(ctx context.Context, filter *MetricFilter) ([]types.MetricInfo, error) {
+	if filter == nil {
+		filter = &MetricFilter{}
 	}
+	var clauses []string
+	if filter.Realtime {
+		clauses = append(clauses, "isRealtimeAvailable eq true")
+	}
+	if filter.Historical {
+		clauses = append(clauses, "isHistoricalAvailable eq true")
+	}
+	query := strings.Join(clauses, " and ")
 
-	metricInstance := &types.MetricInstance{}
-	for _, entry := range result.Entries {
-		instanceURI := fmt.Sprintf(api.UnityAPIGetResourceURI, api.UnityMetric, strconv.Itoa(entry.Cnt.ID))
-		err = c.executeWithRetryAuthenticate(ctx, http.MethodGet, instanceURI, nil, metricInstance)
-		fmt.Printf("%d - %s - %s\n", metricInstance.Content.ID, metricInstance.Content.Path, metricInstance.Content.Description)
+	c.metricCatalog.Lock()
+	metrics, ok := c.metricCatalog.metrics[query]
+	c.metricCatalog.Unlock()
+	if !ok {
+		var err error
+		metrics, err = c.listMetricCatalog(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list metrics: %v", err)
+		}
+		c.metricCatalog.Lock()
+		if c.metricCatalog.metrics == nil {
+			c.metricCatalog.metrics = make(map[string][]types.MetricInfo)
+		}
+		c.metricCatalog.metrics[query] = metrics
+		c.metricCatalog.Unlock()
 	}
 
-	return nil
+	result := make([]types.MetricInfo, 0, len(metrics))
+	for _, metric := range metrics {
+		if strings.HasPrefix(metric.Path, filter.PathPrefix) {
+			result = append(result, metric)
+		}
+	}
+	return result, nil
+}
+
+// listMetricCatalog retrieves all the pages of metrics matching the filter query
+func (c *UnityClientImpl) listMetricCatalog(ctx context.Context, query string) ([]types.MetricInfo, error) {
+	log := c.log(ctx)
+	baseURI := fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.UnityMetric, MetricInfoFields)
+	if query != "" {
+		baseURI += "&filter=" + url.QueryEscape(query)
+	}
+
+	var metrics []types.MetricInfo
+	for page := 1; ; page++ {
+		queryURI := fmt.Sprintf(baseURI+"&per_page=%d&page=%d", metricCatalogPageSize, page)
+		log.Debugf("ListMetrics: %s", queryURI)
+		result := &types.ListMetricInfo{}
+		err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, queryURI, nil, result)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range result.Entries {
+			metrics = append(metrics, entry.Content)
+		}
+		if len(result.Entries) == 0 || !hasNextPage(result.Links) {
+			return metrics, nil
+		}
+	}
 }
 
 // GetMetricsCollection gets Unity MetricsCollection of the provided 'queryID'.
 // - The MetricCollection should exist already or you can create one using CreateXXXMetricsQuery.
 // - Example: GET /api/types/metricQueryResult/instances?filter=queryId eq 37
 func (c *UnityClientImpl) GetMetricsCollection(ctx context.Context, queryID int) (*types.MetricQueryResult, error) {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 
 	filter := fmt.Sprintf("queryId eq %d", queryID)
 	queryURI := fmt.Sprintf(api.UnityInstancesFilter, api.UnityMetricQueryResult, url.QueryEscape(filter))
@@ -78,7 +157,7 @@ func (c *UnityClientImpl) GetMetricsCollection(ctx context.Context, queryID int)
 //     "interval": 5
 //     }
 func (c *UnityClientImpl) CreateRealTimeMetricsQuery(ctx context.Context, metricPaths []string, interval int) (*types.MetricQueryCreateResponse, error) {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 
 	createURI := fmt.Sprintf(api.UnityAPIInstanceTypeResources, api.UnityMetricRealTimeQuery)
 	log.Info("CreateRealTimeMetricQuery: ", createURI)
@@ -99,7 +178,7 @@ func (c *UnityClientImpl) CreateRealTimeMetricsQuery(ctx context.Context, metric
 // DeleteRealTimeMetricsQuery deletes the MetricRealTime Collection of the given queryID.
 // - Example: DELETE /api/instances/metricRealTimeQuery/37
 func (c *UnityClientImpl) DeleteRealTimeMetricsQuery(ctx context.Context, queryID int) error {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	deleteURI := fmt.Sprintf(api.UnityAPIGetResourceURI, api.UnityMetricRealTimeQuery, strconv.Itoa(queryID))
 	log.Info("DeleteRealTimeMetricsQuery:", deleteURI)
 
@@ -114,7 +193,7 @@ func (c *UnityClientImpl) DeleteRealTimeMetricsQuery(ctx context.Context, queryI
 // GetCapacity gets Unity capacity metrics at the system level.
 // - Example: GET /api/types/systemCapacity/instances?fields=id,sizeFree,sizeTotal,sizeUsed,sizePreallocated,sizeSubscribed,totalLogicalSize
 func (c *UnityClientImpl) GetCapacity(ctx context.Context) (*types.SystemCapacityMetricsQueryResult, error) {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 
 	queryURI := fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.UnitySystemCapacity, SystemCapacityFields)
 	log.Info("GetSystemCapacityMetrics: ", queryURI)
@@ -127,3 +206,124 @@ func (c *UnityClientImpl) GetCapacity(ctx context.Context) (*types.SystemCapacit
 
 	return systemCapacityMetricsQueryResult, nil
 }
+
+// Historical metric intervals, in seconds, kept by Unity
+const (
+	HistoricalMetricInterval1Minute  = 60
+	HistoricalMetricInterval5Minutes = 300
+	HistoricalMetricInterval1Hour    = 3600
+	HistoricalMetricInterval4Hours   = 14400
+)
+
+// metricValuePageSize is the number of historical samples requested per page
+const metricValuePageSize = 1000
+
+// HistoricalMetricsQuery holds the parameters of a historical metrics query
+type HistoricalMetricsQuery struct {
+	Paths []string
+	// Interval is the sampling interval in seconds. All intervals are returned when zero.
+	Interval int
+	// StartTime and EndTime bound the samples returned. A zero value leaves the bound open.
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// GetHistoricalMetrics gets the historical samples of the given metric paths as time series, one per path, interval and object.
+// - All the pages of the result are retrieved.
+// - Example: GET /api/types/metricValue/instances?filter=path EQ "sp.*.cpu.summary.busyTicks" and timestamp GE "2025-01-01T00:00:00.000Z"&per_page=1000&page=1
+func (c *UnityClientImpl) GetHistoricalMetrics(ctx context.Context, query *HistoricalMetricsQuery) ([]types.MetricSeries, error) {
+	if query == nil || len(query.Paths) == 0 {
+		return nil, errors.New("metric paths shouldn't be empty")
+	}
+	if !query.StartTime.IsZero() && !query.EndTime.IsZero() && !query.EndTime.After(query.StartTime) {
+		return nil, errors.New("end time should be after start time")
+	}
+
+	var series []types.MetricSeries
+	for _, path := range query.Paths {
+		values, err := c.listMetricValues(ctx, path, query)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get historical metrics for path %s: %v", path, err)
+		}
+		pathSeries, err := metricValuesToSeries(path, values)
+		if err != nil {
+			return nil, err
+		}
+		series = append(series, pathSeries...)
+	}
+	return series, nil
+}
+
+// listMetricValues retrieves all the pages of historical samples of a single metric path
+func (c *UnityClientImpl) listMetricValues(ctx context.Context, path string, query *HistoricalMetricsQuery) ([]types.MetricValueContent, error) {
+	log := c.log(ctx)
+	clauses := []string{fmt.Sprintf("path EQ %s", quoteFilterValue(path))}
+	if query.Interval != 0 {
+		clauses = append(clauses, fmt.Sprintf("interval EQ %d", query.Interval))
+	}
+	clauses = append(clauses, timeRangeClauses("timestamp", query.StartTime, query.EndTime)...)
+	filter := url.QueryEscape(strings.Join(clauses, " and "))
+
+	var values []types.MetricValueContent
+	for page := 1; ; page++ {
+		queryURI := fmt.Sprintf(api.UnityInstancesFilterWithPage, api.UnityMetricValue, filter, metricValuePageSize, page)
+		log.Debugf("GetHistoricalMetrics: %s", queryURI)
+		result := &types.ListMetricValue{}
+		err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, queryURI, nil, result)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range result.Entries {
+			values = append(values, entry.Content)
+		}
+		if len(result.Entries) == 0 || !hasNextPage(result.Links) {
+			return values, nil
+		}
+	}
+}
+
+// metricSeriesKey identifies a time series of a path, the samples of different intervals are not mixed
+type metricSeriesKey struct {
+	interval int
+	instance string
+}
+
+// metricValuesToSeries groups the historical samples of a path into one time series per interval and object, ordered by time
+func metricValuesToSeries(path string, values []types.MetricValueContent) ([]types.MetricSeries, error) {
+	seriesByKey := make(map[metricSeriesKey]*types.MetricSeries)
+	for _, value := range values {
+		timestamp, err := time.Parse(time.RFC3339, value.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp %s for metric %s: %v", value.Timestamp, path, err)
+		}
+		// The values which are not numbers are skipped, the other objects keep their series
+		samples, _ := flattenMetricValues(value.Values)
+		for instance, sample := range samples {
+			key := metricSeriesKey{interval: value.Interval, instance: instance}
+			s, ok := seriesByKey[key]
+			if !ok {
+				s = &types.MetricSeries{Path: path, InstanceKey: instance, Interval: value.Interval}
+				seriesByKey[key] = s
+			}
+			s.Points = append(s.Points, types.MetricPoint{Timestamp: timestamp, Value: sample})
+		}
+	}
+
+	keys := make([]metricSeriesKey, 0, len(seriesByKey))
+	for key := range seriesByKey {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].interval != keys[j].interval {
+			return keys[i].interval < keys[j].interval
+		}
+		return keys[i].instance < keys[j].instance
+	})
+	series := make([]types.MetricSeries, 0, len(keys))
+	for _, key := range keys {
+		s := seriesByKey[key]
+		sort.Slice(s.Points, func(i, j int) bool { return s.Points[i].Timestamp.Before(s.Points[j].Timestamp) })
+		series = append(series, *s)
+	}
+	return series, nil
+}
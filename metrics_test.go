@@ -18,7 +18,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	mocksapi "github.com/dell/gounity/mocks/api"
 	"github.com/dell/gounity/types"
@@ -77,38 +79,85 @@ func TestGetMetricsCollection(t *testing.T) {
 func TestGetAllRealTimeMetricPaths(t *testing.T) {
 	fmt.Println("Begin - GetAllRealTimeMetricPaths Test")
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	testConf.client.(*UnityClientImpl).metricCatalog.metrics = nil
 	ctx := context.Background()
 
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(nil).Run(func(args mock.Arguments) {
-		resp := args.Get(5).(*types.MetricPaths)
-		*resp = types.MetricPaths{
-			Entries: []types.MetricEntries{
+		resp := args.Get(5).(*types.ListMetricInfo)
+		*resp = types.ListMetricInfo{
+			Entries: []types.MetricInstance{
 				{
-					Cnt: types.MetricContent{
-						ID: 12345,
+					Content: types.MetricInfo{
+						ID:                  12345,
+						IsRealtimeAvailable: true,
 					},
 				},
 			},
 		}
 	}).Once()
-	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(nil).Run(func(args mock.Arguments) {
-		resp := args.Get(5).(*types.MetricInstance)
-		*resp = types.MetricInstance{
-			Content: types.MetricInfo{
-				IsRealtimeAvailable: true,
-			},
-		}
-	}).Once()
 	err := testConf.client.GetAllRealTimeMetricPaths(ctx)
 	assert.Nil(t, err)
 
+	testConf.client.(*UnityClientImpl).metricCatalog.metrics = nil
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("get real time metrics failed")).Once()
 	err = testConf.client.GetAllRealTimeMetricPaths(ctx)
 	assert.Error(t, err)
 
+	testConf.client.(*UnityClientImpl).metricCatalog.metrics = nil
 	fmt.Println("GetAllRealTimeMetricPaths Test - Successful")
 }
 
+func TestListMetrics(t *testing.T) {
+	fmt.Println("Begin - List Metrics Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	testConf.client.(*UnityClientImpl).metricCatalog.metrics = nil
+	ctx := context.Background()
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+
+	isPage := func(page int) interface{} {
+		return mock.MatchedBy(func(uri string) bool {
+			return strings.HasPrefix(uri, "/api/types/metric/instances?fields="+MetricInfoFields+"&filter=isRealtimeAvailable+eq+true&") &&
+				strings.HasSuffix(uri, fmt.Sprintf("&per_page=%d&page=%d", metricCatalogPageSize, page))
+		})
+	}
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", isPage(1), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListMetricInfo)
+		*resp = types.ListMetricInfo{
+			Links: []types.Link{{Rel: "next", Href: "&page=2"}},
+			Entries: []types.MetricInstance{
+				{Content: types.MetricInfo{ID: 10, Path: "sp.*.cpu.summary.busyTicks", IsRealtimeAvailable: true}},
+			},
+		}
+	}).Once()
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", isPage(2), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListMetricInfo)
+		*resp = types.ListMetricInfo{
+			Entries: []types.MetricInstance{
+				{Content: types.MetricInfo{ID: 20, Path: "sp.*.storage.lun.*.readsRate", IsRealtimeAvailable: true}},
+			},
+		}
+	}).Once()
+
+	metrics, err := testConf.client.ListMetrics(ctx, &MetricFilter{Realtime: true})
+	assert.NoError(t, err)
+	assert.Len(t, metrics, 2)
+
+	// Served from the cache, filtered by path
+	metrics, err = testConf.client.ListMetrics(ctx, &MetricFilter{Realtime: true, PathPrefix: "sp.*.storage"})
+	assert.NoError(t, err)
+	assert.Len(t, metrics, 1)
+	assert.Equal(t, 20, metrics[0].ID)
+	mockClient.AssertExpectations(t)
+
+	// Negative case
+	mockClient.On("DoWithHeaders", anyArgs...).Return(errors.New("list metrics failed")).Once()
+	_, err = testConf.client.ListMetrics(ctx, nil)
+	assert.Error(t, err)
+
+	testConf.client.(*UnityClientImpl).metricCatalog.metrics = nil
+	fmt.Println("List Metrics Test - Successful")
+}
+
 func TestCreateRealTimeMetricsQuery(t *testing.T) {
 	fmt.Println("Begin - CreateRealTimeMetricsQuery Test")
 	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
@@ -153,3 +202,126 @@ func TestGetCapacity(t *testing.T) {
 
 	fmt.Println("GetCapacity Test - Successful")
 }
+
+func TestGetHistoricalMetrics(t *testing.T) {
+	fmt.Println("Begin - Get Historical Metrics Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := &HistoricalMetricsQuery{
+		Paths:     []string{"sp.*.cpu.summary.busyTicks"},
+		Interval:  HistoricalMetricInterval1Minute,
+		StartTime: start,
+		EndTime:   start.Add(24 * time.Hour),
+	}
+
+	isPage := func(page int) interface{} {
+		return mock.MatchedBy(func(uri string) bool {
+			return strings.HasPrefix(uri, "/api/types/metricValue/instances?filter=") &&
+				strings.Contains(uri, "interval+EQ+60") &&
+				strings.Contains(uri, "timestamp+GE+%222025-01-01T00%3A00%3A00.000Z%22") &&
+				strings.HasSuffix(uri, fmt.Sprintf("&per_page=%d&page=%d", metricValuePageSize, page))
+		})
+	}
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", isPage(1), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListMetricValue)
+		*resp = types.ListMetricValue{
+			Links: []types.Link{{Rel: "self", Href: "&page=1"}, {Rel: "next", Href: "&page=2"}},
+			Entries: []types.MetricValueEntry{
+				{Content: types.MetricValueContent{Path: "sp.*.cpu.summary.busyTicks", Timestamp: "2025-01-01T00:01:00.000Z", Interval: 60, Values: map[string]interface{}{"spa": 20.0, "spb": "10"}}},
+			},
+		}
+	}).Once()
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", isPage(2), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListMetricValue)
+		*resp = types.ListMetricValue{
+			Entries: []types.MetricValueEntry{
+				{Content: types.MetricValueContent{Path: "sp.*.cpu.summary.busyTicks", Timestamp: "2025-01-01T00:00:00.000Z", Interval: 60, Values: map[string]interface{}{"spa": 10.0, "spb": 5.0}}},
+			},
+		}
+	}).Once()
+
+	series, err := testConf.client.GetHistoricalMetrics(ctx, query)
+	assert.NoError(t, err)
+	assert.Len(t, series, 2)
+	assert.Equal(t, "spa", series[0].InstanceKey)
+	assert.Equal(t, 60, series[0].Interval)
+	assert.Len(t, series[0].Points, 2)
+	assert.Equal(t, start, series[0].Points[0].Timestamp)
+	assert.Equal(t, 10.0, series[0].Points[0].Value)
+	assert.Equal(t, 20.0, series[0].Points[1].Value)
+	assert.Equal(t, "spb", series[1].InstanceKey)
+	assert.Equal(t, 10.0, series[1].Points[1].Value)
+
+	// Nested values of per object metrics
+	mockClient.On("DoWithHeaders", anyArgs...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListMetricValue)
+		*resp = types.ListMetricValue{
+			Entries: []types.MetricValueEntry{
+				{Content: types.MetricValueContent{Timestamp: "2025-01-01T00:00:00.000Z", Values: map[string]interface{}{"spa": map[string]interface{}{"sv_1": 1.5, "sv_2": 2.5}}}},
+			},
+		}
+	}).Once()
+	series, err = testConf.client.GetHistoricalMetrics(ctx, &HistoricalMetricsQuery{Paths: []string{"sp.*.storage.lun.*.readsRate"}})
+	assert.NoError(t, err)
+	assert.Len(t, series, 2)
+	assert.Equal(t, "spa/sv_1", series[0].InstanceKey)
+	assert.Equal(t, 2.5, series[1].Points[0].Value)
+
+	// Samples of different intervals are kept in different series, and quotes of the path are escaped
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.MatchedBy(func(uri string) bool {
+		return strings.Contains(uri, "path+EQ+%22sp.%5C%22a%5C%22.cpu%22")
+	}), mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListMetricValue)
+		*resp = types.ListMetricValue{
+			Entries: []types.MetricValueEntry{
+				{Content: types.MetricValueContent{Timestamp: "2025-01-01T00:00:00.000Z", Interval: 300, Values: map[string]interface{}{"spa": 3.0}}},
+				{Content: types.MetricValueContent{Timestamp: "2025-01-01T00:00:00.000Z", Interval: 60, Values: map[string]interface{}{"spa": 1.0}}},
+				{Content: types.MetricValueContent{Timestamp: "2025-01-01T00:01:00.000Z", Interval: 60, Values: map[string]interface{}{"spa": 2.0}}},
+			},
+		}
+	}).Once()
+	series, err = testConf.client.GetHistoricalMetrics(ctx, &HistoricalMetricsQuery{Paths: []string{`sp."a".cpu`}})
+	assert.NoError(t, err)
+	assert.Len(t, series, 2)
+	assert.Equal(t, 60, series[0].Interval)
+	assert.Len(t, series[0].Points, 2)
+	assert.Equal(t, 300, series[1].Interval)
+	assert.Equal(t, []types.MetricPoint{{Timestamp: start, Value: 3.0}}, series[1].Points)
+
+	// Negative cases
+	_, err = testConf.client.GetHistoricalMetrics(ctx, &HistoricalMetricsQuery{})
+	assert.Error(t, err)
+
+	_, err = testConf.client.GetHistoricalMetrics(ctx, &HistoricalMetricsQuery{Paths: query.Paths, StartTime: start, EndTime: start})
+	assert.Error(t, err)
+
+	mockClient.On("DoWithHeaders", anyArgs...).Return(errors.New("get historical metrics failed")).Once()
+	_, err = testConf.client.GetHistoricalMetrics(ctx, query)
+	assert.Error(t, err)
+
+	mockClient.On("DoWithHeaders", anyArgs...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListMetricValue)
+		*resp = types.ListMetricValue{
+			Entries: []types.MetricValueEntry{{Content: types.MetricValueContent{Timestamp: "invalid"}}},
+		}
+	}).Once()
+	_, err = testConf.client.GetHistoricalMetrics(ctx, query)
+	assert.Error(t, err)
+
+	mockClient.On("DoWithHeaders", anyArgs...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListMetricValue)
+		*resp = types.ListMetricValue{
+			Entries: []types.MetricValueEntry{{Content: types.MetricValueContent{Timestamp: "2025-01-01T00:00:00.000Z", Values: map[string]interface{}{"spa": true, "spb": 1.0}}}},
+		}
+	}).Once()
+	// The values which are not numbers are skipped
+	series, err = testConf.client.GetHistoricalMetrics(ctx, query)
+	assert.NoError(t, err)
+	assert.Len(t, series, 1)
+	assert.Equal(t, "spb", series[0].InstanceKey)
+
+	fmt.Println("Get Historical Metrics Test - Successful")
+}
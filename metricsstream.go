@@ -0,0 +1,191 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dell/gounity/types"
+)
+
+// metricsStreamIntervalUnit is the unit of the metrics stream interval
+var metricsStreamIntervalUnit = time.Second
+
+// metricsStreamCleanupTimeout bounds the deletion of the real time query once the stream is stopped
+const metricsStreamCleanupTimeout = 30 * time.Second
+
+// MetricsStream polls a real time metrics query at its interval and emits the new samples on a channel.
+// The stream owns the query: it is recreated when it expires and deleted when the context is cancelled.
+type MetricsStream struct {
+	client   *UnityClientImpl
+	paths    []string
+	interval int
+	samples  chan types.MetricSample
+
+	queryID    int
+	expiration time.Time
+	// lastTimestamps holds the timestamp of the latest sample emitted for each path
+	lastTimestamps map[string]time.Time
+
+	errMutex sync.Mutex
+	err      error
+}
+
+// NewMetricsStream creates a real time metrics query of the given metric paths and interval in seconds and starts
+// polling it. The samples channel is closed when ctx is cancelled or polling fails, see Err.
+func (c *UnityClientImpl) NewMetricsStream(ctx context.Context, metricPaths []string, interval int) (*MetricsStream, error) {
+	if len(metricPaths) == 0 {
+		return nil, errors.New("metric paths shouldn't be empty")
+	}
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid metrics stream interval %d", interval)
+	}
+	s := &MetricsStream{
+		client:         c,
+		paths:          metricPaths,
+		interval:       interval,
+		samples:        make(chan types.MetricSample),
+		lastTimestamps: make(map[string]time.Time),
+	}
+	if err := s.createQuery(ctx); err != nil {
+		return nil, err
+	}
+	go s.run(ctx)
+	return s, nil
+}
+
+// Samples returns the channel the new samples are emitted on, ordered by time
+func (s *MetricsStream) Samples() <-chan types.MetricSample {
+	return s.samples
+}
+
+// Err returns the error which stopped the stream, if any. It is nil when the stream was stopped by cancelling its context.
+func (s *MetricsStream) Err() error {
+	s.errMutex.Lock()
+	defer s.errMutex.Unlock()
+	return s.err
+}
+
+func (s *MetricsStream) run(ctx context.Context) {
+	log := s.client.log(ctx)
+	defer close(s.samples)
+	defer s.deleteQuery(ctx)
+
+	ticker := time.NewTicker(time.Duration(s.interval) * metricsStreamIntervalUnit)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := s.poll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Errorf("Metrics stream of query %d stopped: %v", s.queryID, err)
+			s.errMutex.Lock()
+			s.err = err
+			s.errMutex.Unlock()
+			return
+		}
+	}
+}
+
+// poll retrieves the results of the query and emits the samples not emitted yet
+func (s *MetricsStream) poll(ctx context.Context) error {
+	log := s.client.log(ctx)
+	if !s.expiration.IsZero() && time.Now().Add(time.Duration(s.interval)*metricsStreamIntervalUnit).After(s.expiration) {
+		log.Debugf("Real time metrics query %d is about to expire, recreating it", s.queryID)
+		s.deleteQuery(ctx)
+		return s.createQuery(ctx)
+	}
+
+	result, err := s.client.GetMetricsCollection(ctx, s.queryID)
+	if err != nil {
+		if !isNotFoundError(err) {
+			return err
+		}
+		log.Debugf("Real time metrics query %d expired, recreating it", s.queryID)
+		return s.createQuery(ctx)
+	}
+
+	entries := make([][]types.MetricSample, 0, len(result.Entries))
+	for _, entry := range result.Entries {
+		samples, skipped, err := decodeMetricResult(entry.Content)
+		if err != nil {
+			return err
+		}
+		if len(skipped) > 0 {
+			log.Debugf("Skipped the non numeric values of metric %s for %s", entry.Content.Path, strings.Join(skipped, ", "))
+		}
+		if len(samples) > 0 {
+			entries = append(entries, samples)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i][0].Timestamp.Before(entries[j][0].Timestamp) })
+
+	for _, samples := range entries {
+		path, timestamp := samples[0].Path, samples[0].Timestamp
+		if !timestamp.After(s.lastTimestamps[path]) {
+			continue
+		}
+		s.lastTimestamps[path] = timestamp
+		for _, sample := range samples {
+			select {
+			case s.samples <- sample:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+func (s *MetricsStream) createQuery(ctx context.Context) error {
+	resp, err := s.client.CreateRealTimeMetricsQuery(ctx, s.paths, s.interval)
+	if err != nil {
+		return fmt.Errorf("unable to create real time metrics query: %v", err)
+	}
+	s.queryID = resp.Content.ID
+	s.expiration = time.Time{}
+	if expiration, err := time.Parse(time.RFC3339, resp.Content.Expiration); err == nil {
+		s.expiration = expiration
+	}
+	return nil
+}
+
+// deleteQuery deletes the query. The deletion is not bound to ctx so that it completes once ctx is cancelled.
+func (s *MetricsStream) deleteQuery(ctx context.Context) {
+	log := s.client.log(ctx)
+	cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), metricsStreamCleanupTimeout)
+	defer cancel()
+	if err := s.client.DeleteRealTimeMetricsQuery(cleanupCtx, s.queryID); err != nil && !isNotFoundError(err) {
+		log.Warnf("Unable to delete real time metrics query %d: %v", s.queryID, err)
+	}
+}
+
+// isNotFoundError checks whether the array reported the resource as not found
+func isNotFoundError(err error) bool {
+	e, ok := err.(*types.Error)
+	return ok && e.ErrorContent.HTTPStatusCode == http.StatusNotFound
+}
@@ -0,0 +1,134 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/dell/gounity/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func metricResultEntry(path, timestamp string, values map[string]interface{}) types.MetricResultEntry {
+	return types.MetricResultEntry{Content: types.MetricResult{Path: path, Timestamp: timestamp, Values: values}}
+}
+
+func TestMetricsStream(t *testing.T) {
+	fmt.Println("Begin - Metrics Stream Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+	defer func(unit time.Duration) { metricsStreamIntervalUnit = unit }(metricsStreamIntervalUnit)
+	metricsStreamIntervalUnit = time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	path := "sp.*.cpu.summary.busyTicks"
+	expiration := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	createQuery := func(queryID int) {
+		mockClient.On("DoWithHeaders", mock.Anything, "POST", "/api/types/metricRealTimeQuery/instances", mock.Anything, mock.Anything, mock.AnythingOfType("*types.MetricQueryCreateResponse")).Return(nil).Run(func(args mock.Arguments) {
+			resp := args.Get(5).(*types.MetricQueryCreateResponse)
+			resp.Content = types.MetricQueryResponseContent{ID: queryID, Expiration: expiration}
+		}).Once()
+	}
+	getResults := func(entries ...types.MetricResultEntry) {
+		mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.MetricQueryResult")).Return(nil).Run(func(args mock.Arguments) {
+			resp := args.Get(5).(*types.MetricQueryResult)
+			resp.Entries = entries
+		}).Once()
+	}
+
+	// Registered first as the nil response of the deletion cannot be matched against the typed responses below
+	mockClient.On("DoWithHeaders", mock.Anything, "DELETE", "/api/instances/metricRealTimeQuery/2", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	createQuery(1)
+	getResults(
+		metricResultEntry(path, "2025-01-01T00:00:10.000Z", map[string]interface{}{"spa": 2.0, "spb": 20.0}),
+		metricResultEntry(path, "2025-01-01T00:00:05.000Z", map[string]interface{}{"spa": 1.0, "spb": 10.0}),
+	)
+	getResults(
+		metricResultEntry(path, "2025-01-01T00:00:05.000Z", map[string]interface{}{"spa": 1.0, "spb": 10.0}),
+		metricResultEntry(path, "2025-01-01T00:00:10.000Z", map[string]interface{}{"spa": 2.0, "spb": 20.0}),
+		// A null value does not stop the stream nor the other objects
+		metricResultEntry(path, "2025-01-01T00:00:15.000Z", map[string]interface{}{"spa": 3.0, "spb": nil}),
+	)
+	// The query expired on the array
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.MetricQueryResult")).Return(
+		&types.Error{ErrorContent: types.ErrorContent{HTTPStatusCode: http.StatusNotFound}}).Once()
+	createQuery(2)
+	getResults(
+		metricResultEntry(path, "2025-01-01T00:00:15.000Z", map[string]interface{}{"spa": 3.0}),
+		metricResultEntry(path, "2025-01-01T00:00:20.000Z", map[string]interface{}{"spa": 4.0}),
+	)
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.MetricQueryResult")).Return(nil)
+
+	stream, err := testConf.client.NewMetricsStream(ctx, []string{path}, 5)
+	assert.NoError(t, err)
+
+	var values []float64
+	for sample := range stream.Samples() {
+		assert.Equal(t, path, sample.Path)
+		values = append(values, sample.Value)
+		if len(values) == 6 {
+			cancel()
+		}
+	}
+	assert.Equal(t, []float64{1, 10, 2, 20, 3, 4}, values)
+	assert.NoError(t, stream.Err())
+	mockClient.AssertCalled(t, "DoWithHeaders", mock.Anything, "DELETE", "/api/instances/metricRealTimeQuery/2", mock.Anything, mock.Anything, mock.Anything)
+
+	fmt.Println("Metrics Stream Test - Successful")
+}
+
+func TestMetricsStreamErrors(t *testing.T) {
+	fmt.Println("Begin - Metrics Stream Errors Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+	defer func(unit time.Duration) { metricsStreamIntervalUnit = unit }(metricsStreamIntervalUnit)
+	metricsStreamIntervalUnit = time.Millisecond
+	ctx := context.Background()
+
+	_, err := testConf.client.NewMetricsStream(ctx, nil, 5)
+	assert.Error(t, err)
+
+	_, err = testConf.client.NewMetricsStream(ctx, []string{"sp.*.cpu.summary.busyTicks"}, 0)
+	assert.Error(t, err)
+
+	mockClient.On("DoWithHeaders", mock.Anything, "POST", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("create failed")).Once()
+	_, err = testConf.client.NewMetricsStream(ctx, []string{"sp.*.cpu.summary.busyTicks"}, 5)
+	assert.Error(t, err)
+
+	// Polling fails with an error other than an expired query
+	mockClient.On("DoWithHeaders", mock.Anything, "POST", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.MetricQueryCreateResponse)
+		resp.Content = types.MetricQueryResponseContent{ID: 3}
+	}).Once()
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("poll failed")).Once()
+	mockClient.On("DoWithHeaders", mock.Anything, "DELETE", "/api/instances/metricRealTimeQuery/3", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	stream, err := testConf.client.NewMetricsStream(ctx, []string{"sp.*.cpu.summary.busyTicks"}, 5)
+	assert.NoError(t, err)
+	for range stream.Samples() {
+		t.Fatal("no sample expected")
+	}
+	assert.Error(t, stream.Err())
+	mockClient.AssertExpectations(t)
+
+	fmt.Println("Metrics Stream Errors Test - Successful")
+}
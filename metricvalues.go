@@ -0,0 +1,201 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/dell/gounity/types"
+)
+
+// MetricType is the type of a Unity metric as reported in the metric catalog
+type MetricType int
+
+// Metric types reported by Unity
+const (
+	MetricTypeCounter32        MetricType = 2
+	MetricTypeCounter64        MetricType = 3
+	MetricTypeRate             MetricType = 4
+	MetricTypeFact             MetricType = 5
+	MetricTypeText             MetricType = 6
+	MetricTypeVirtualCounter32 MetricType = 7
+	MetricTypeVirtualCounter64 MetricType = 8
+)
+
+// IsCounter checks whether the metric is a monotonically increasing counter which needs to be converted to a rate
+func (t MetricType) IsCounter() bool {
+	switch t {
+	case MetricTypeCounter32, MetricTypeCounter64, MetricTypeVirtualCounter32, MetricTypeVirtualCounter64:
+		return true
+	}
+	return false
+}
+
+// DecodeMetricResult flattens the values of a metric query result into samples ordered by instance key.
+// The values which are not numbers, e.g. null for an object without data, are skipped.
+func DecodeMetricResult(result types.MetricResult) ([]types.MetricSample, error) {
+	samples, _, err := decodeMetricResult(result)
+	return samples, err
+}
+
+// decodeMetricResult decodes the samples of the result and returns the instance keys of the skipped values
+func decodeMetricResult(result types.MetricResult) ([]types.MetricSample, []string, error) {
+	timestamp, err := time.Parse(time.RFC3339, result.Timestamp)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid timestamp %s for metric %s: %v", result.Timestamp, result.Path, err)
+	}
+	values, skipped := flattenMetricValues(result.Values)
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	samples := make([]types.MetricSample, 0, len(keys))
+	for _, key := range keys {
+		samples = append(samples, types.MetricSample{Path: result.Path, InstanceKey: key, Timestamp: timestamp, Value: values[key]})
+	}
+	return samples, skipped, nil
+}
+
+// DecodeMetricQueryResult flattens all the entries of a metric query result into samples
+func DecodeMetricQueryResult(result *types.MetricQueryResult) ([]types.MetricSample, error) {
+	if result == nil {
+		return nil, nil
+	}
+	var samples []types.MetricSample
+	for _, entry := range result.Entries {
+		entrySamples, err := DecodeMetricResult(entry.Content)
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, entrySamples...)
+	}
+	return samples, nil
+}
+
+// MetricRateCalculator converts successive samples of counter metrics into per second rates, using the metric
+// catalog to tell counters from metrics which already are rates or facts. It is not safe for concurrent use.
+type MetricRateCalculator struct {
+	metrics  map[string]types.MetricInfo
+	previous map[string]types.MetricSample
+}
+
+// NewMetricRateCalculator creates a MetricRateCalculator from the metric catalog, see ListMetrics
+func NewMetricRateCalculator(catalog []types.MetricInfo) *MetricRateCalculator {
+	metrics := make(map[string]types.MetricInfo, len(catalog))
+	for _, metric := range catalog {
+		metrics[metric.Path] = metric
+	}
+	return &MetricRateCalculator{
+		metrics:  metrics,
+		previous: make(map[string]types.MetricSample),
+	}
+}
+
+// Rate returns the value of the sample as a rate along with its unit. Counters are converted to a per second rate
+// against the previous sample of the same path and instance, so false is returned for the first sample, for samples
+// not newer than the previous one and after a counter reset. Other metrics are returned unchanged.
+func (r *MetricRateCalculator) Rate(sample types.MetricSample) (float64, string, bool) {
+	metric, ok := r.metrics[sample.Path]
+	if !ok {
+		return 0, "", false
+	}
+	metricType := MetricType(metric.Type)
+	if !metricType.IsCounter() {
+		return sample.Value, metric.UnitDisplayString, metricType != MetricTypeText
+	}
+
+	key := sample.Path + metricInstanceKeySeparator + sample.InstanceKey
+	previous, ok := r.previous[key]
+	if ok && !sample.Timestamp.After(previous.Timestamp) {
+		return 0, "", false
+	}
+	r.previous[key] = sample
+	if !ok {
+		return 0, "", false
+	}
+
+	delta := sample.Value - previous.Value
+	if delta < 0 {
+		if metricType != MetricTypeCounter32 && metricType != MetricTypeVirtualCounter32 {
+			// 64 bit counters do not wrap, the counter was reset
+			return 0, "", false
+		}
+		delta += math.MaxUint32 + 1
+	}
+	return delta / sample.Timestamp.Sub(previous.Timestamp).Seconds(), metric.UnitDisplayString + "/s", true
+}
+
+// CPUBusyPercent computes the CPU utilization in percent from the busy and idle ticks elapsed over the same
+// period, e.g. the rates of sp.*.cpu.summary.busyTicks and sp.*.cpu.summary.idleTicks
+func CPUBusyPercent(busyTicks, idleTicks float64) float64 {
+	if busyTicks+idleTicks <= 0 {
+		return 0
+	}
+	return busyTicks * 100 / (busyTicks + idleTicks)
+}
+
+// metricInstanceKeySeparator joins the object identifiers of nested metric values, e.g. "spa/sv_1"
+const metricInstanceKeySeparator = "/"
+
+// flattenMetricValues flattens the possibly nested values of a metric sample into a map of instance key to value.
+// The instance keys of the values which are not numbers are returned sorted, without failing the other values.
+func flattenMetricValues(values map[string]interface{}) (map[string]float64, []string) {
+	samples := make(map[string]float64)
+	var skipped []string
+	var walk func(prefix string, value interface{})
+	walk = func(prefix string, value interface{}) {
+		if nested, ok := value.(map[string]interface{}); ok {
+			for key, v := range nested {
+				childKey := key
+				if prefix != "" {
+					childKey = prefix + metricInstanceKeySeparator + key
+				}
+				walk(childKey, v)
+			}
+			return
+		}
+		sample, err := metricValueToFloat(value)
+		if err != nil {
+			skipped = append(skipped, prefix)
+			return
+		}
+		samples[prefix] = sample
+	}
+	for key, value := range values {
+		walk(key, value)
+	}
+	sort.Strings(skipped)
+	return samples, skipped
+}
+
+// metricValueToFloat converts a single metric value to float64. Unity returns large counters as strings.
+func metricValueToFloat(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case json.Number:
+		return v.Float64()
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, fmt.Errorf("unsupported metric value type %T", value)
+	}
+}
@@ -0,0 +1,131 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dell/gounity/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeMetricResult(t *testing.T) {
+	fmt.Println("Begin - Decode Metric Result Test")
+
+	var values map[string]interface{}
+	err := json.Unmarshal([]byte(`{"spa": {"sv_1": 1.5, "sv_2": "18446744073709551615"}, "spb": {"sv_1": 3}}`), &values)
+	assert.NoError(t, err)
+	samples, err := DecodeMetricResult(types.MetricResult{Path: "sp.*.storage.lun.*.reads", Timestamp: "2025-01-01T00:00:05.000Z", Values: values})
+	assert.NoError(t, err)
+	assert.Len(t, samples, 3)
+	assert.Equal(t, "spa/sv_1", samples[0].InstanceKey)
+	assert.Equal(t, 1.5, samples[0].Value)
+	assert.Equal(t, float64(math.MaxUint64), samples[1].Value)
+	assert.Equal(t, "spb/sv_1", samples[2].InstanceKey)
+	assert.Equal(t, "sp.*.storage.lun.*.reads", samples[2].Path)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 5, 0, time.UTC), samples[2].Timestamp)
+
+	samples, err = DecodeMetricQueryResult(&types.MetricQueryResult{Entries: []types.MetricResultEntry{
+		{Content: types.MetricResult{Path: "sp.*.cpu.summary.busyTicks", Timestamp: "2025-01-01T00:00:05.000Z", Values: map[string]interface{}{"spa": 1.0}}},
+		{Content: types.MetricResult{Path: "sp.*.cpu.summary.idleTicks", Timestamp: "2025-01-01T00:00:05.000Z", Values: map[string]interface{}{"spa": 3.0}}},
+	}})
+	assert.NoError(t, err)
+	assert.Len(t, samples, 2)
+
+	samples, err = DecodeMetricQueryResult(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, samples)
+
+	// Negative cases
+	_, err = DecodeMetricResult(types.MetricResult{Timestamp: "invalid"})
+	assert.Error(t, err)
+
+	_, err = DecodeMetricQueryResult(&types.MetricQueryResult{Entries: []types.MetricResultEntry{{Content: types.MetricResult{Timestamp: "invalid"}}}})
+	assert.Error(t, err)
+
+	// Values which are not numbers are skipped, the other objects are kept
+	samples, skipped, err := decodeMetricResult(types.MetricResult{Timestamp: "2025-01-01T00:00:05.000Z", Values: map[string]interface{}{
+		"spa": map[string]interface{}{"sv_1": "busy", "sv_2": 2.0},
+		"spb": nil,
+	}})
+	assert.NoError(t, err)
+	assert.Len(t, samples, 1)
+	assert.Equal(t, "spa/sv_2", samples[0].InstanceKey)
+	assert.Equal(t, []string{"spa/sv_1", "spb"}, skipped)
+
+	fmt.Println("Decode Metric Result Test - Successful")
+}
+
+func TestMetricRateCalculator(t *testing.T) {
+	fmt.Println("Begin - Metric Rate Calculator Test")
+
+	calculator := NewMetricRateCalculator([]types.MetricInfo{
+		{Path: "sp.*.cpu.summary.busyTicks", Type: int(MetricTypeCounter64), UnitDisplayString: "Ticks"},
+		{Path: "sp.*.storage.lun.*.reads", Type: int(MetricTypeCounter32), UnitDisplayString: "I/O"},
+		{Path: "sp.*.storage.lun.*.readsRate", Type: int(MetricTypeRate), UnitDisplayString: "I/O/s"},
+		{Path: "sp.*.physical.coreCount", Type: int(MetricTypeText)},
+	})
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	sample := func(path string, seconds int, value float64) types.MetricSample {
+		return types.MetricSample{Path: path, InstanceKey: "spa", Timestamp: start.Add(time.Duration(seconds) * time.Second), Value: value}
+	}
+
+	// First sample of a counter has no rate
+	_, _, ok := calculator.Rate(sample("sp.*.cpu.summary.busyTicks", 0, 100))
+	assert.False(t, ok)
+	rate, unit, ok := calculator.Rate(sample("sp.*.cpu.summary.busyTicks", 5, 600))
+	assert.True(t, ok)
+	assert.Equal(t, 100.0, rate)
+	assert.Equal(t, "Ticks/s", unit)
+
+	// Samples not newer than the previous one are ignored
+	_, _, ok = calculator.Rate(sample("sp.*.cpu.summary.busyTicks", 5, 600))
+	assert.False(t, ok)
+
+	// 64 bit counter reset
+	_, _, ok = calculator.Rate(sample("sp.*.cpu.summary.busyTicks", 10, 50))
+	assert.False(t, ok)
+	rate, _, ok = calculator.Rate(sample("sp.*.cpu.summary.busyTicks", 15, 100))
+	assert.True(t, ok)
+	assert.Equal(t, 10.0, rate)
+
+	// 32 bit counter wrap
+	calculator.Rate(sample("sp.*.storage.lun.*.reads", 0, math.MaxUint32-9))
+	rate, unit, ok = calculator.Rate(sample("sp.*.storage.lun.*.reads", 10, 10))
+	assert.True(t, ok)
+	assert.Equal(t, 2.0, rate)
+	assert.Equal(t, "I/O/s", unit)
+
+	// Rates are returned unchanged
+	rate, unit, ok = calculator.Rate(sample("sp.*.storage.lun.*.readsRate", 0, 42))
+	assert.True(t, ok)
+	assert.Equal(t, 42.0, rate)
+	assert.Equal(t, "I/O/s", unit)
+
+	// Text and unknown metrics have no rate
+	_, _, ok = calculator.Rate(sample("sp.*.physical.coreCount", 0, 8))
+	assert.False(t, ok)
+	_, _, ok = calculator.Rate(sample("sp.*.unknown", 0, 8))
+	assert.False(t, ok)
+
+	assert.Equal(t, 25.0, CPUBusyPercent(25, 75))
+	assert.Equal(t, 0.0, CPUBusyPercent(0, 0))
+
+	fmt.Println("Metric Rate Calculator Test - Successful")
+}
@@ -9,6 +9,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	types "github.com/dell/gounity/types"
 )
 
@@ -17,6 +19,24 @@ type UnityClient struct {
 	mock.Mock
 }
 
+// AcknowledgeAlert provides a mock function with given fields: ctx, alertID
+func (_m *UnityClient) AcknowledgeAlert(ctx context.Context, alertID string) error {
+	ret := _m.Called(ctx, alertID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcknowledgeAlert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, alertID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Authenticate provides a mock function with given fields: ctx, configConnect
 func (_m *UnityClient) Authenticate(ctx context.Context, configConnect *gounity.ConfigConnect) error {
 	ret := _m.Called(ctx, configConnect)
@@ -36,16 +56,66 @@ func (_m *UnityClient) Authenticate(ctx context.Context, configConnect *gounity.
 }
 
 // BasicSystemInfo provides a mock function with given fields: ctx, configConnect
-func (_m *UnityClient) BasicSystemInfo(ctx context.Context, configConnect *gounity.ConfigConnect) error {
+func (_m *UnityClient) BasicSystemInfo(ctx context.Context, configConnect *gounity.ConfigConnect) (*types.BasicSystemInfo, error) {
 	ret := _m.Called(ctx, configConnect)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BasicSystemInfo")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *gounity.ConfigConnect) error); ok {
+	var r0 *types.BasicSystemInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.ConfigConnect) (*types.BasicSystemInfo, error)); ok {
+		return rf(ctx, configConnect)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.ConfigConnect) *types.BasicSystemInfo); ok {
 		r0 = rf(ctx, configConnect)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BasicSystemInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gounity.ConfigConnect) error); ok {
+		r1 = rf(ctx, configConnect)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Capabilities provides a mock function with no fields
+func (_m *UnityClient) Capabilities() *gounity.Capabilities {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Capabilities")
+	}
+
+	var r0 *gounity.Capabilities
+	if rf, ok := ret.Get(0).(func() *gounity.Capabilities); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gounity.Capabilities)
+		}
+	}
+
+	return r0
+}
+
+// Close provides a mock function with no fields
+func (_m *UnityClient) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -233,6 +303,36 @@ func (_m *UnityClient) CreateHostInitiator(ctx context.Context, hostID string, w
 	return r0, r1
 }
 
+// CreateHostWithOptions provides a mock function with given fields: ctx, opts
+func (_m *UnityClient) CreateHostWithOptions(ctx context.Context, opts *gounity.CreateHostOptions) (*types.Host, error) {
+	ret := _m.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateHostWithOptions")
+	}
+
+	var r0 *types.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.CreateHostOptions) (*types.Host, error)); ok {
+		return rf(ctx, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.CreateHostOptions) *types.Host); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gounity.CreateHostOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateLun provides a mock function with given fields: ctx, name, poolID, description, size, fastVPTieringPolicy, hostIOLimitID, isThinEnabled, isDataReductionEnabled
 func (_m *UnityClient) CreateLun(ctx context.Context, name string, poolID string, description string, size uint64, fastVPTieringPolicy int, hostIOLimitID string, isThinEnabled bool, isDataReductionEnabled bool) (*types.Volume, error) {
 	ret := _m.Called(ctx, name, poolID, description, size, fastVPTieringPolicy, hostIOLimitID, isThinEnabled, isDataReductionEnabled)
@@ -413,6 +513,36 @@ func (_m *UnityClient) CreateSnapshotWithFsAccesType(ctx context.Context, storag
 	return r0, r1
 }
 
+// CreateStoragePool provides a mock function with given fields: ctx, opts
+func (_m *UnityClient) CreateStoragePool(ctx context.Context, opts *gounity.CreateStoragePoolOptions) (*types.StoragePool, error) {
+	ret := _m.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStoragePool")
+	}
+
+	var r0 *types.StoragePool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.CreateStoragePoolOptions) (*types.StoragePool, error)); ok {
+		return rf(ctx, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.CreateStoragePoolOptions) *types.StoragePool); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StoragePool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gounity.CreateStoragePoolOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreteLunThinClone provides a mock function with given fields: ctx, name, snapID, volID
 func (_m *UnityClient) CreteLunThinClone(ctx context.Context, name string, snapID string, volID string) (*types.Volume, error) {
 	ret := _m.Called(ctx, name, snapID, volID)
@@ -443,6 +573,24 @@ func (_m *UnityClient) CreteLunThinClone(ctx context.Context, name string, snapI
 	return r0, r1
 }
 
+// DeleteAlert provides a mock function with given fields: ctx, alertID
+func (_m *UnityClient) DeleteAlert(ctx context.Context, alertID string) error {
+	ret := _m.Called(ctx, alertID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAlert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, alertID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteFilesystem provides a mock function with given fields: ctx, filesystemID
 func (_m *UnityClient) DeleteFilesystem(ctx context.Context, filesystemID string) error {
 	ret := _m.Called(ctx, filesystemID)
@@ -497,6 +645,42 @@ func (_m *UnityClient) DeleteHost(ctx context.Context, hostName string) error {
 	return r0
 }
 
+// DeleteHostByID provides a mock function with given fields: ctx, hostID
+func (_m *UnityClient) DeleteHostByID(ctx context.Context, hostID string) error {
+	ret := _m.Called(ctx, hostID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHostByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, hostID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteHostInitiator provides a mock function with given fields: ctx, initiatorID
+func (_m *UnityClient) DeleteHostInitiator(ctx context.Context, initiatorID string) error {
+	ret := _m.Called(ctx, initiatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHostInitiator")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, initiatorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteNFSShare provides a mock function with given fields: ctx, filesystemID, nfsShareID
 func (_m *UnityClient) DeleteNFSShare(ctx context.Context, filesystemID string, nfsShareID string) error {
 	ret := _m.Called(ctx, filesystemID, nfsShareID)
@@ -605,6 +789,24 @@ func (_m *UnityClient) ExpandFilesystem(ctx context.Context, filesystemID string
 	return r0
 }
 
+// ExpandStoragePool provides a mock function with given fields: ctx, poolID, raidGroups
+func (_m *UnityClient) ExpandStoragePool(ctx context.Context, poolID string, raidGroups []gounity.RaidGroupSpec) error {
+	ret := _m.Called(ctx, poolID, raidGroups)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandStoragePool")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []gounity.RaidGroupSpec) error); ok {
+		r0 = rf(ctx, poolID, raidGroups)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ExpandVolume provides a mock function with given fields: ctx, volumeID, newSize
 func (_m *UnityClient) ExpandVolume(ctx context.Context, volumeID string, newSize uint64) error {
 	ret := _m.Called(ctx, volumeID, newSize)
@@ -1259,6 +1461,36 @@ func (_m *UnityClient) GetCapacity(ctx context.Context) (*types.SystemCapacityMe
 	return r0, r1
 }
 
+// GetCapacityReport provides a mock function with given fields: ctx
+func (_m *UnityClient) GetCapacityReport(ctx context.Context) (*types.CapacityReport, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCapacityReport")
+	}
+
+	var r0 *types.CapacityReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*types.CapacityReport, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *types.CapacityReport); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.CapacityReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetFilesystemIDFromResID provides a mock function with given fields: ctx, filesystemResID
 func (_m *UnityClient) GetFilesystemIDFromResID(ctx context.Context, filesystemResID string) (string, error) {
 	ret := _m.Called(ctx, filesystemResID)
@@ -1287,29 +1519,29 @@ func (_m *UnityClient) GetFilesystemIDFromResID(ctx context.Context, filesystemR
 	return r0, r1
 }
 
-// GetMaxVolumeSize provides a mock function with given fields: ctx, systemLimitID
-func (_m *UnityClient) GetMaxVolumeSize(ctx context.Context, systemLimitID string) (*types.MaxVolumSizeInfo, error) {
-	ret := _m.Called(ctx, systemLimitID)
+// GetFilesystemPerformance provides a mock function with given fields: ctx, filesystemID, window
+func (_m *UnityClient) GetFilesystemPerformance(ctx context.Context, filesystemID string, window time.Duration) (*types.ResourcePerformance, error) {
+	ret := _m.Called(ctx, filesystemID, window)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMaxVolumeSize")
+		panic("no return value specified for GetFilesystemPerformance")
 	}
 
-	var r0 *types.MaxVolumSizeInfo
+	var r0 *types.ResourcePerformance
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*types.MaxVolumSizeInfo, error)); ok {
-		return rf(ctx, systemLimitID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) (*types.ResourcePerformance, error)); ok {
+		return rf(ctx, filesystemID, window)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *types.MaxVolumSizeInfo); ok {
-		r0 = rf(ctx, systemLimitID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) *types.ResourcePerformance); ok {
+		r0 = rf(ctx, filesystemID, window)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.MaxVolumSizeInfo)
+			r0 = ret.Get(0).(*types.ResourcePerformance)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, systemLimitID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = rf(ctx, filesystemID, window)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1317,29 +1549,29 @@ func (_m *UnityClient) GetMaxVolumeSize(ctx context.Context, systemLimitID strin
 	return r0, r1
 }
 
-// GetMetricsCollection provides a mock function with given fields: ctx, queryID
-func (_m *UnityClient) GetMetricsCollection(ctx context.Context, queryID int) (*types.MetricQueryResult, error) {
-	ret := _m.Called(ctx, queryID)
+// GetHistoricalMetrics provides a mock function with given fields: ctx, query
+func (_m *UnityClient) GetHistoricalMetrics(ctx context.Context, query *gounity.HistoricalMetricsQuery) ([]types.MetricSeries, error) {
+	ret := _m.Called(ctx, query)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMetricsCollection")
+		panic("no return value specified for GetHistoricalMetrics")
 	}
 
-	var r0 *types.MetricQueryResult
+	var r0 []types.MetricSeries
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (*types.MetricQueryResult, error)); ok {
-		return rf(ctx, queryID)
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.HistoricalMetricsQuery) ([]types.MetricSeries, error)); ok {
+		return rf(ctx, query)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) *types.MetricQueryResult); ok {
-		r0 = rf(ctx, queryID)
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.HistoricalMetricsQuery) []types.MetricSeries); ok {
+		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.MetricQueryResult)
+			r0 = ret.Get(0).([]types.MetricSeries)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, queryID)
+	if rf, ok := ret.Get(1).(func(context.Context, *gounity.HistoricalMetricsQuery) error); ok {
+		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1347,47 +1579,59 @@ func (_m *UnityClient) GetMetricsCollection(ctx context.Context, queryID int) (*
 	return r0, r1
 }
 
-// GetToken provides a mock function with no fields
-func (_m *UnityClient) GetToken() string {
-	ret := _m.Called()
+// GetLicense provides a mock function with given fields: ctx, licenseName
+func (_m *UnityClient) GetLicense(ctx context.Context, licenseName gounity.LicenseType) (*types.LicenseInfo, error) {
+	ret := _m.Called(ctx, licenseName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetToken")
+		panic("no return value specified for GetLicense")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
+	var r0 *types.LicenseInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.LicenseType) (*types.LicenseInfo, error)); ok {
+		return rf(ctx, licenseName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.LicenseType) *types.LicenseInfo); ok {
+		r0 = rf(ctx, licenseName)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.LicenseInfo)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, gounity.LicenseType) error); ok {
+		r1 = rf(ctx, licenseName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ListHostInitiators provides a mock function with given fields: ctx
-func (_m *UnityClient) ListHostInitiators(ctx context.Context) ([]types.HostInitiator, error) {
-	ret := _m.Called(ctx)
+// GetLunPerformance provides a mock function with given fields: ctx, lunID, window
+func (_m *UnityClient) GetLunPerformance(ctx context.Context, lunID string, window time.Duration) (*types.ResourcePerformance, error) {
+	ret := _m.Called(ctx, lunID, window)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListHostInitiators")
+		panic("no return value specified for GetLunPerformance")
 	}
 
-	var r0 []types.HostInitiator
+	var r0 *types.ResourcePerformance
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]types.HostInitiator, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) (*types.ResourcePerformance, error)); ok {
+		return rf(ctx, lunID, window)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []types.HostInitiator); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) *types.ResourcePerformance); ok {
+		r0 = rf(ctx, lunID, window)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.HostInitiator)
+			r0 = ret.Get(0).(*types.ResourcePerformance)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = rf(ctx, lunID, window)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1395,29 +1639,29 @@ func (_m *UnityClient) ListHostInitiators(ctx context.Context) ([]types.HostInit
 	return r0, r1
 }
 
-// ListIscsiIPInterfaces provides a mock function with given fields: ctx
-func (_m *UnityClient) ListIscsiIPInterfaces(ctx context.Context) ([]types.IPInterfaceEntries, error) {
-	ret := _m.Called(ctx)
+// GetMaxVolumeSize provides a mock function with given fields: ctx, systemLimitID
+func (_m *UnityClient) GetMaxVolumeSize(ctx context.Context, systemLimitID string) (*types.MaxVolumSizeInfo, error) {
+	ret := _m.Called(ctx, systemLimitID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListIscsiIPInterfaces")
+		panic("no return value specified for GetMaxVolumeSize")
 	}
 
-	var r0 []types.IPInterfaceEntries
+	var r0 *types.MaxVolumSizeInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]types.IPInterfaceEntries, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*types.MaxVolumSizeInfo, error)); ok {
+		return rf(ctx, systemLimitID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []types.IPInterfaceEntries); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *types.MaxVolumSizeInfo); ok {
+		r0 = rf(ctx, systemLimitID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.IPInterfaceEntries)
+			r0 = ret.Get(0).(*types.MaxVolumSizeInfo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, systemLimitID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1425,19 +1669,607 @@ func (_m *UnityClient) ListIscsiIPInterfaces(ctx context.Context) ([]types.IPInt
 	return r0, r1
 }
 
-// ListSnapshots provides a mock function with given fields: ctx, startToken, maxEntries, sourceVolumeID, snapshotID
-func (_m *UnityClient) ListSnapshots(ctx context.Context, startToken int, maxEntries int, sourceVolumeID string, snapshotID string) ([]types.Snapshot, int, error) {
-	ret := _m.Called(ctx, startToken, maxEntries, sourceVolumeID, snapshotID)
+// GetMetricsCollection provides a mock function with given fields: ctx, queryID
+func (_m *UnityClient) GetMetricsCollection(ctx context.Context, queryID int) (*types.MetricQueryResult, error) {
+	ret := _m.Called(ctx, queryID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListSnapshots")
+		panic("no return value specified for GetMetricsCollection")
 	}
 
-	var r0 []types.Snapshot
-	var r1 int
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, int, string, string) ([]types.Snapshot, int, error)); ok {
-		return rf(ctx, startToken, maxEntries, sourceVolumeID, snapshotID)
+	var r0 *types.MetricQueryResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*types.MetricQueryResult, error)); ok {
+		return rf(ctx, queryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *types.MetricQueryResult); ok {
+		r0 = rf(ctx, queryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MetricQueryResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, queryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSystemInfo provides a mock function with given fields: ctx
+func (_m *UnityClient) GetSystemInfo(ctx context.Context) (*types.SystemInfo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSystemInfo")
+	}
+
+	var r0 *types.SystemInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*types.SystemInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *types.SystemInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SystemInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSystemLimit provides a mock function with given fields: ctx, limitID
+func (_m *UnityClient) GetSystemLimit(ctx context.Context, limitID gounity.SystemLimitID) (*types.SystemLimit, error) {
+	ret := _m.Called(ctx, limitID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSystemLimit")
+	}
+
+	var r0 *types.SystemLimit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.SystemLimitID) (*types.SystemLimit, error)); ok {
+		return rf(ctx, limitID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.SystemLimitID) *types.SystemLimit); ok {
+		r0 = rf(ctx, limitID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SystemLimit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gounity.SystemLimitID) error); ok {
+		r1 = rf(ctx, limitID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTargetInfo provides a mock function with given fields: ctx
+func (_m *UnityClient) GetTargetInfo(ctx context.Context) (*types.TargetInfo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTargetInfo")
+	}
+
+	var r0 *types.TargetInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*types.TargetInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *types.TargetInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.TargetInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetToken provides a mock function with no fields
+func (_m *UnityClient) GetToken() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetToken")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ListAlerts provides a mock function with given fields: ctx, filter
+func (_m *UnityClient) ListAlerts(ctx context.Context, filter *gounity.AlertFilter) ([]types.Alert, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAlerts")
+	}
+
+	var r0 []types.Alert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.AlertFilter) ([]types.Alert, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.AlertFilter) []types.Alert); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Alert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gounity.AlertFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEthernetPorts provides a mock function with given fields: ctx
+func (_m *UnityClient) ListEthernetPorts(ctx context.Context) ([]types.EthernetPort, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEthernetPorts")
+	}
+
+	var r0 []types.EthernetPort
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.EthernetPort, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.EthernetPort); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.EthernetPort)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEvents provides a mock function with given fields: ctx, filter
+func (_m *UnityClient) ListEvents(ctx context.Context, filter *gounity.EventFilter) ([]types.Event, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEvents")
+	}
+
+	var r0 []types.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.EventFilter) ([]types.Event, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.EventFilter) []types.Event); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gounity.EventFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListFCPorts provides a mock function with given fields: ctx
+func (_m *UnityClient) ListFCPorts(ctx context.Context) ([]types.FcPort, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFCPorts")
+	}
+
+	var r0 []types.FcPort
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.FcPort, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.FcPort); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.FcPort)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListFeatures provides a mock function with given fields: ctx
+func (_m *UnityClient) ListFeatures(ctx context.Context) ([]types.Feature, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeatures")
+	}
+
+	var r0 []types.Feature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.Feature, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.Feature); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Feature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListFilesystems provides a mock function with given fields: ctx
+func (_m *UnityClient) ListFilesystems(ctx context.Context) ([]types.Filesystem, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFilesystems")
+	}
+
+	var r0 []types.Filesystem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.Filesystem, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.Filesystem); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Filesystem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListHardwareComponents provides a mock function with given fields: ctx, componentType
+func (_m *UnityClient) ListHardwareComponents(ctx context.Context, componentType gounity.HardwareComponentType) ([]types.HardwareComponent, error) {
+	ret := _m.Called(ctx, componentType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHardwareComponents")
+	}
+
+	var r0 []types.HardwareComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.HardwareComponentType) ([]types.HardwareComponent, error)); ok {
+		return rf(ctx, componentType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.HardwareComponentType) []types.HardwareComponent); ok {
+		r0 = rf(ctx, componentType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.HardwareComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gounity.HardwareComponentType) error); ok {
+		r1 = rf(ctx, componentType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListHostInitiators provides a mock function with given fields: ctx
+func (_m *UnityClient) ListHostInitiators(ctx context.Context) ([]types.HostInitiator, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHostInitiators")
+	}
+
+	var r0 []types.HostInitiator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.HostInitiator, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.HostInitiator); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.HostInitiator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListHostLUNs provides a mock function with given fields: ctx, hostID
+func (_m *UnityClient) ListHostLUNs(ctx context.Context, hostID string) ([]types.HostLUN, error) {
+	ret := _m.Called(ctx, hostID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHostLUNs")
+	}
+
+	var r0 []types.HostLUN
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]types.HostLUN, error)); ok {
+		return rf(ctx, hostID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []types.HostLUN); ok {
+		r0 = rf(ctx, hostID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.HostLUN)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, hostID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListHosts provides a mock function with given fields: ctx, filter
+func (_m *UnityClient) ListHosts(ctx context.Context, filter *gounity.HostFilter) ([]types.Host, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHosts")
+	}
+
+	var r0 []types.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.HostFilter) ([]types.Host, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.HostFilter) []types.Host); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gounity.HostFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListISCSIPortals provides a mock function with given fields: ctx
+func (_m *UnityClient) ListISCSIPortals(ctx context.Context) ([]types.IscsiPortal, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListISCSIPortals")
+	}
+
+	var r0 []types.IscsiPortal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.IscsiPortal, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.IscsiPortal); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.IscsiPortal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListInitiatorPaths provides a mock function with given fields: ctx, initiatorID
+func (_m *UnityClient) ListInitiatorPaths(ctx context.Context, initiatorID string) ([]types.HostInitiatorPath, error) {
+	ret := _m.Called(ctx, initiatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInitiatorPaths")
+	}
+
+	var r0 []types.HostInitiatorPath
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]types.HostInitiatorPath, error)); ok {
+		return rf(ctx, initiatorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []types.HostInitiatorPath); ok {
+		r0 = rf(ctx, initiatorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.HostInitiatorPath)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, initiatorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListIscsiIPInterfaces provides a mock function with given fields: ctx
+func (_m *UnityClient) ListIscsiIPInterfaces(ctx context.Context) ([]types.IPInterfaceEntries, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIscsiIPInterfaces")
+	}
+
+	var r0 []types.IPInterfaceEntries
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.IPInterfaceEntries, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.IPInterfaceEntries); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.IPInterfaceEntries)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLicenses provides a mock function with given fields: ctx
+func (_m *UnityClient) ListLicenses(ctx context.Context) ([]types.LicenseInfo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLicenses")
+	}
+
+	var r0 []types.LicenseInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.LicenseInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.LicenseInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.LicenseInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMetrics provides a mock function with given fields: ctx, filter
+func (_m *UnityClient) ListMetrics(ctx context.Context, filter *gounity.MetricFilter) ([]types.MetricInfo, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMetrics")
+	}
+
+	var r0 []types.MetricInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.MetricFilter) ([]types.MetricInfo, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gounity.MetricFilter) []types.MetricInfo); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.MetricInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gounity.MetricFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSnapshots provides a mock function with given fields: ctx, startToken, maxEntries, sourceVolumeID, snapshotID
+func (_m *UnityClient) ListSnapshots(ctx context.Context, startToken int, maxEntries int, sourceVolumeID string, snapshotID string) ([]types.Snapshot, int, error) {
+	ret := _m.Called(ctx, startToken, maxEntries, sourceVolumeID, snapshotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSnapshots")
+	}
+
+	var r0 []types.Snapshot
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string, string) ([]types.Snapshot, int, error)); ok {
+		return rf(ctx, startToken, maxEntries, sourceVolumeID, snapshotID)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, int, int, string, string) []types.Snapshot); ok {
 		r0 = rf(ctx, startToken, maxEntries, sourceVolumeID, snapshotID)
@@ -1462,6 +2294,66 @@ func (_m *UnityClient) ListSnapshots(ctx context.Context, startToken int, maxEnt
 	return r0, r1, r2
 }
 
+// ListStoragePools provides a mock function with given fields: ctx
+func (_m *UnityClient) ListStoragePools(ctx context.Context) ([]types.StoragePool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStoragePools")
+	}
+
+	var r0 []types.StoragePool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.StoragePool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.StoragePool); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StoragePool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSystemLimits provides a mock function with given fields: ctx
+func (_m *UnityClient) ListSystemLimits(ctx context.Context) ([]types.SystemLimit, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSystemLimits")
+	}
+
+	var r0 []types.SystemLimit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]types.SystemLimit, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []types.SystemLimit); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.SystemLimit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListVolumes provides a mock function with given fields: ctx, startToken, maxEntries
 func (_m *UnityClient) ListVolumes(ctx context.Context, startToken int, maxEntries int) ([]types.Volume, int, error) {
 	ret := _m.Called(ctx, startToken, maxEntries)
@@ -1499,6 +2391,42 @@ func (_m *UnityClient) ListVolumes(ctx context.Context, startToken int, maxEntri
 	return r0, r1, r2
 }
 
+// Logout provides a mock function with given fields: ctx
+func (_m *UnityClient) Logout(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ModifyHost provides a mock function with given fields: ctx, hostID, opts
+func (_m *UnityClient) ModifyHost(ctx context.Context, hostID string, opts *gounity.ModifyHostOptions) error {
+	ret := _m.Called(ctx, hostID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ModifyHost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *gounity.ModifyHostOptions) error); ok {
+		r0 = rf(ctx, hostID, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ModifyHostInitiator provides a mock function with given fields: ctx, hostID, initiator
 func (_m *UnityClient) ModifyHostInitiator(ctx context.Context, hostID string, initiator *types.HostInitiator) (*types.HostInitiator, error) {
 	ret := _m.Called(ctx, hostID, initiator)
@@ -1631,6 +2559,24 @@ func (_m *UnityClient) ModifySnapshotAutoDeleteParameter(ctx context.Context, sn
 	return r0
 }
 
+// ModifyStoragePool provides a mock function with given fields: ctx, poolID, opts
+func (_m *UnityClient) ModifyStoragePool(ctx context.Context, poolID string, opts *gounity.ModifyStoragePoolOptions) error {
+	ret := _m.Called(ctx, poolID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ModifyStoragePool")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *gounity.ModifyStoragePoolOptions) error); ok {
+		r0 = rf(ctx, poolID, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ModifyVolumeExport provides a mock function with given fields: ctx, volID, hostIDList
 func (_m *UnityClient) ModifyVolumeExport(ctx context.Context, volID string, hostIDList []string) error {
 	ret := _m.Called(ctx, volID, hostIDList)
@@ -1649,6 +2595,36 @@ func (_m *UnityClient) ModifyVolumeExport(ctx context.Context, volID string, hos
 	return r0
 }
 
+// NewMetricsStream provides a mock function with given fields: ctx, metricPaths, interval
+func (_m *UnityClient) NewMetricsStream(ctx context.Context, metricPaths []string, interval int) (*gounity.MetricsStream, error) {
+	ret := _m.Called(ctx, metricPaths, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewMetricsStream")
+	}
+
+	var r0 *gounity.MetricsStream
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int) (*gounity.MetricsStream, error)); ok {
+		return rf(ctx, metricPaths, interval)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int) *gounity.MetricsStream); ok {
+		r0 = rf(ctx, metricPaths, interval)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gounity.MetricsStream)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, int) error); ok {
+		r1 = rf(ctx, metricPaths, interval)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RenameVolume provides a mock function with given fields: ctx, newName, volID
 func (_m *UnityClient) RenameVolume(ctx context.Context, newName string, volID string) error {
 	ret := _m.Called(ctx, newName, volID)
@@ -1667,11 +2643,136 @@ func (_m *UnityClient) RenameVolume(ctx context.Context, newName string, volID s
 	return r0
 }
 
+// SessionStats provides a mock function with no fields
+func (_m *UnityClient) SessionStats() gounity.SessionStats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SessionStats")
+	}
+
+	var r0 gounity.SessionStats
+	if rf, ok := ret.Get(0).(func() gounity.SessionStats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(gounity.SessionStats)
+	}
+
+	return r0
+}
+
+// SetInitiatorCHAP provides a mock function with given fields: ctx, initiatorID, chap
+func (_m *UnityClient) SetInitiatorCHAP(ctx context.Context, initiatorID string, chap *gounity.InitiatorCHAP) error {
+	ret := _m.Called(ctx, initiatorID, chap)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetInitiatorCHAP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *gounity.InitiatorCHAP) error); ok {
+		r0 = rf(ctx, initiatorID, chap)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetInitiatorIgnored provides a mock function with given fields: ctx, initiatorID, ignored
+func (_m *UnityClient) SetInitiatorIgnored(ctx context.Context, initiatorID string, ignored bool) error {
+	ret := _m.Called(ctx, initiatorID, ignored)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetInitiatorIgnored")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, initiatorID, ignored)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetMutualCHAP provides a mock function with given fields: ctx, username, secret
+func (_m *UnityClient) SetMutualCHAP(ctx context.Context, username string, secret string) error {
+	ret := _m.Called(ctx, username, secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMutualCHAP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, username, secret)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetToken provides a mock function with given fields: token
 func (_m *UnityClient) SetToken(token string) {
 	_m.Called(token)
 }
 
+// StartKeepalive provides a mock function with given fields: ctx, opts
+func (_m *UnityClient) StartKeepalive(ctx context.Context, opts gounity.KeepaliveOptions) error {
+	ret := _m.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartKeepalive")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gounity.KeepaliveOptions) error); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StopKeepalive provides a mock function with no fields
+func (_m *UnityClient) StopKeepalive() {
+	_m.Called()
+}
+
+// SystemHealthSummary provides a mock function with given fields: ctx
+func (_m *UnityClient) SystemHealthSummary(ctx context.Context) (*types.SystemHealthSummary, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SystemHealthSummary")
+	}
+
+	var r0 *types.SystemHealthSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*types.SystemHealthSummary, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *types.SystemHealthSummary); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SystemHealthSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnexportVolume provides a mock function with given fields: ctx, volID
 func (_m *UnityClient) UnexportVolume(ctx context.Context, volID string) error {
 	ret := _m.Called(ctx, volID)
@@ -1690,6 +2791,26 @@ func (_m *UnityClient) UnexportVolume(ctx context.Context, volID string) error {
 	return r0
 }
 
+// WatchAlerts provides a mock function with given fields: ctx, since
+func (_m *UnityClient) WatchAlerts(ctx context.Context, since time.Time) <-chan types.Alert {
+	ret := _m.Called(ctx, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchAlerts")
+	}
+
+	var r0 <-chan types.Alert
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) <-chan types.Alert); ok {
+		r0 = rf(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan types.Alert)
+		}
+	}
+
+	return r0
+}
+
 // NewUnityClient creates a new instance of UnityClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUnityClient(t interface {
@@ -0,0 +1,70 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dell/gounity/api"
+	"github.com/dell/gounity/types"
+)
+
+// listPageSize is the number of instances requested per page by pagedListURI
+const listPageSize = 1000
+
+// filterTimestampLayout is the layout of the timestamps used in query filters
+const filterTimestampLayout = "2006-01-02T15:04:05.000Z"
+
+// pagedListURI returns the URI of a page of the instances of a resource type matching the filter clauses
+func pagedListURI(resource string, clauses []string, fields string, page int) string {
+	if len(clauses) == 0 {
+		return fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFieldsAndPage, resource, fields, listPageSize, page)
+	}
+	filter := url.QueryEscape(strings.Join(clauses, " and "))
+	return fmt.Sprintf(api.UnityInstancesFilterWithFieldsAndPage, resource, filter, fields, listPageSize, page)
+}
+
+// hasNextPage reports whether the links of a list response point to a next page
+func hasNextPage(links []types.Link) bool {
+	for _, link := range links {
+		if link.Rel == "next" {
+			return true
+		}
+	}
+	return false
+}
+
+// timeRangeClauses returns the filter clauses bounding a time attribute
+func timeRangeClauses(attribute string, since, until time.Time) []string {
+	var clauses []string
+	if !since.IsZero() {
+		clauses = append(clauses, fmt.Sprintf("%s GE \"%s\"", attribute, since.UTC().Format(filterTimestampLayout)))
+	}
+	if !until.IsZero() {
+		clauses = append(clauses, fmt.Sprintf("%s LT \"%s\"", attribute, until.UTC().Format(filterTimestampLayout)))
+	}
+	return clauses
+}
+
+// filterValueEscaper escapes the backslashes and double quotes of a filter string value
+var filterValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// quoteFilterValue returns a string value quoted for a filter clause
+func quoteFilterValue(value string) string {
+	return `"` + filterValueEscaper.Replace(value) + `"`
+}
@@ -0,0 +1,162 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dell/gounity/types"
+)
+
+// Historical metric paths of the per LUN performance counters. Values are keyed by SP, then by LUN ID.
+const (
+	lunReadsRatePath      = "sp.*.storage.lun.*.readsRate"
+	lunWritesRatePath     = "sp.*.storage.lun.*.writesRate"
+	lunReadBytesRatePath  = "sp.*.storage.lun.*.readBytesRate"
+	lunWriteBytesRatePath = "sp.*.storage.lun.*.writeBytesRate"
+	lunResponseTimePath   = "sp.*.storage.lun.*.responseTime"
+	lunQueueLengthPath    = "sp.*.storage.lun.*.queueLength"
+)
+
+// Historical metric paths of the per filesystem performance counters. Values are keyed by SP, then by filesystem ID.
+const (
+	fsReadsRatePath      = "sp.*.storage.filesystem.*.readsRate"
+	fsWritesRatePath     = "sp.*.storage.filesystem.*.writesRate"
+	fsReadBytesRatePath  = "sp.*.storage.filesystem.*.readBytesRate"
+	fsWriteBytesRatePath = "sp.*.storage.filesystem.*.writeBytesRate"
+	fsResponseTimePath   = "sp.*.storage.filesystem.*.clientOpsTimeAvg"
+	fsQueueLengthPath    = "sp.*.storage.filesystem.*.clientOpsQueueLength"
+)
+
+// performancePaths maps the metric paths of a resource type to the figures they provide
+type performancePaths struct {
+	readIOPS, writeIOPS, readBandwidth, writeBandwidth, responseTime, queueLength string
+}
+
+var (
+	lunPerformancePaths = performancePaths{lunReadsRatePath, lunWritesRatePath, lunReadBytesRatePath, lunWriteBytesRatePath, lunResponseTimePath, lunQueueLengthPath}
+	fsPerformancePaths  = performancePaths{fsReadsRatePath, fsWritesRatePath, fsReadBytesRatePath, fsWriteBytesRatePath, fsResponseTimePath, fsQueueLengthPath}
+)
+
+// GetLunPerformance gets the average performance of the LUN over the given window, ending now
+func (c *UnityClientImpl) GetLunPerformance(ctx context.Context, lunID string, window time.Duration) (*types.ResourcePerformance, error) {
+	if len(lunID) == 0 {
+		return nil, errors.New("LUN ID shouldn't be empty")
+	}
+	return c.getResourcePerformance(ctx, lunID, window, lunPerformancePaths)
+}
+
+// GetFilesystemPerformance gets the average performance of the filesystem over the given window, ending now
+func (c *UnityClientImpl) GetFilesystemPerformance(ctx context.Context, filesystemID string, window time.Duration) (*types.ResourcePerformance, error) {
+	if len(filesystemID) == 0 {
+		return nil, errors.New("Filesystem Id shouldn't be empty")
+	}
+	return c.getResourcePerformance(ctx, filesystemID, window, fsPerformancePaths)
+}
+
+// performanceInterval returns the finest historical interval Unity keeps for the window
+func performanceInterval(window time.Duration) int {
+	switch {
+	case window <= time.Hour:
+		return HistoricalMetricInterval1Minute
+	case window <= 24*time.Hour:
+		return HistoricalMetricInterval5Minutes
+	case window <= 7*24*time.Hour:
+		return HistoricalMetricInterval1Hour
+	default:
+		return HistoricalMetricInterval4Hours
+	}
+}
+
+func (c *UnityClientImpl) getResourcePerformance(ctx context.Context, resourceID string, window time.Duration, paths performancePaths) (*types.ResourcePerformance, error) {
+	if window <= 0 {
+		return nil, fmt.Errorf("invalid performance window %v", window)
+	}
+	end := time.Now()
+	query := &HistoricalMetricsQuery{
+		Paths:     []string{paths.readIOPS, paths.writeIOPS, paths.readBandwidth, paths.writeBandwidth, paths.responseTime, paths.queueLength},
+		Interval:  performanceInterval(window),
+		StartTime: end.Add(-window),
+		EndTime:   end,
+	}
+	series, err := c.GetHistoricalMetrics(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the series of the resource, one per SP
+	byPath := make(map[string][]types.MetricSeries)
+	for _, s := range series {
+		if strings.HasSuffix(s.InstanceKey, metricInstanceKeySeparator+resourceID) {
+			byPath[s.Path] = append(byPath[s.Path], s)
+		}
+	}
+
+	performance := &types.ResourcePerformance{
+		ResourceID: resourceID,
+		Start:      query.StartTime,
+		End:        query.EndTime,
+		Interval:   time.Duration(query.Interval) * time.Second,
+	}
+	var samples int
+	performance.ReadIOPS, samples = averageOfSums(byPath[paths.readIOPS])
+	performance.Samples = samples
+	performance.WriteIOPS, _ = averageOfSums(byPath[paths.writeIOPS])
+	performance.ReadBandwidth, _ = averageOfSums(byPath[paths.readBandwidth])
+	performance.WriteBandwidth, _ = averageOfSums(byPath[paths.writeBandwidth])
+	performance.QueueDepth, _ = averageOfSums(byPath[paths.queueLength])
+	// Response times are reported in microseconds
+	performance.AverageLatency = time.Duration(averageOfPoints(byPath[paths.responseTime]) * float64(time.Microsecond))
+	return performance, nil
+}
+
+// averageOfSums sums the series point by point, e.g. the IOPS served by each SP, and returns the average of the
+// sums along with the number of points averaged
+func averageOfSums(series []types.MetricSeries) (float64, int) {
+	sums := make(map[time.Time]float64)
+	for _, s := range series {
+		for _, point := range s.Points {
+			sums[point.Timestamp] += point.Value
+		}
+	}
+	if len(sums) == 0 {
+		return 0, 0
+	}
+	var total float64
+	for _, sum := range sums {
+		total += sum
+	}
+	return total / float64(len(sums)), len(sums)
+}
+
+// averageOfPoints returns the average of all the points of the series
+func averageOfPoints(series []types.MetricSeries) float64 {
+	var total float64
+	var count int
+	for _, s := range series {
+		for _, point := range s.Points {
+			total += point.Value
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / float64(count)
+}
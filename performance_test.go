@@ -0,0 +1,131 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/dell/gounity/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockPerformanceMetrics answers historical metric queries with two samples per path, one minute apart, for two
+// resources on both SPs. values holds the value of the resource "res_1" on spa by path, spb reports half of it.
+func mockPerformanceMetrics(mockClient *mocksapi.Client, values map[string]float64) {
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListMetricValue")).Return(nil).Run(func(args mock.Arguments) {
+		uri, _ := url.QueryUnescape(args.Get(2).(string))
+		resp := args.Get(5).(*types.ListMetricValue)
+		*resp = types.ListMetricValue{}
+		for path, value := range values {
+			if !strings.Contains(uri, fmt.Sprintf("path EQ \"%s\"", path)) {
+				continue
+			}
+			for _, timestamp := range []string{"2025-01-01T00:00:00.000Z", "2025-01-01T00:01:00.000Z"} {
+				resp.Entries = append(resp.Entries, types.MetricValueEntry{Content: types.MetricValueContent{
+					Path:      path,
+					Timestamp: timestamp,
+					Interval:  60,
+					Values: map[string]interface{}{
+						"spa": map[string]interface{}{"res_1": value, "res_2": 1000.0},
+						"spb": map[string]interface{}{"res_1": value / 2},
+					},
+				}})
+			}
+		}
+	})
+}
+
+func TestGetLunPerformance(t *testing.T) {
+	fmt.Println("Begin - Get LUN Performance Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+
+	mockPerformanceMetrics(mockClient, map[string]float64{
+		lunReadsRatePath:      100,
+		lunWritesRatePath:     50,
+		lunReadBytesRatePath:  4096,
+		lunWriteBytesRatePath: 2048,
+		lunResponseTimePath:   400,
+		lunQueueLengthPath:    2,
+	})
+	performance, err := testConf.client.GetLunPerformance(ctx, "res_1", 24*time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, "res_1", performance.ResourceID)
+	assert.Equal(t, 5*time.Minute, performance.Interval)
+	assert.Equal(t, 2, performance.Samples)
+	assert.Equal(t, 150.0, performance.ReadIOPS)
+	assert.Equal(t, 75.0, performance.WriteIOPS)
+	assert.Equal(t, 6144.0, performance.ReadBandwidth)
+	assert.Equal(t, 3072.0, performance.WriteBandwidth)
+	assert.Equal(t, 300*time.Microsecond, performance.AverageLatency)
+	assert.Equal(t, 3.0, performance.QueueDepth)
+	assert.Equal(t, 24*time.Hour, performance.End.Sub(performance.Start))
+
+	// No metrics kept for the LUN
+	performance, err = testConf.client.GetLunPerformance(ctx, "res_3", time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, performance.Samples)
+	assert.Equal(t, time.Minute, performance.Interval)
+
+	// Negative cases
+	_, err = testConf.client.GetLunPerformance(ctx, "", time.Hour)
+	assert.Error(t, err)
+
+	_, err = testConf.client.GetLunPerformance(ctx, "res_1", 0)
+	assert.Error(t, err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	mockClient.On("DoWithHeaders", anyArgs...).Return(errors.New("get historical metrics failed")).Once()
+	_, err = testConf.client.GetLunPerformance(ctx, "res_1", time.Hour)
+	assert.Error(t, err)
+
+	fmt.Println("Get LUN Performance Test - Successful")
+}
+
+func TestGetFilesystemPerformance(t *testing.T) {
+	fmt.Println("Begin - Get Filesystem Performance Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+
+	mockPerformanceMetrics(mockClient, map[string]float64{
+		fsReadsRatePath:    10,
+		fsWritesRatePath:   20,
+		fsResponseTimePath: 1000,
+	})
+	performance, err := testConf.client.GetFilesystemPerformance(ctx, "res_1", 7*24*time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, performance.Interval)
+	assert.Equal(t, 15.0, performance.ReadIOPS)
+	assert.Equal(t, 30.0, performance.WriteIOPS)
+	assert.Equal(t, 0.0, performance.ReadBandwidth)
+	assert.Equal(t, 750*time.Microsecond, performance.AverageLatency)
+
+	_, err = testConf.client.GetFilesystemPerformance(ctx, "", time.Hour)
+	assert.Error(t, err)
+
+	assert.Equal(t, HistoricalMetricInterval4Hours, performanceInterval(30*24*time.Hour))
+
+	fmt.Println("Get Filesystem Performance Test - Successful")
+}
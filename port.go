@@ -0,0 +1,128 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/dell/gounity/api"
+	"github.com/dell/gounity/types"
+	"github.com/dell/gounity/util"
+)
+
+// IscsiPort is the TCP port iSCSI portals listen on
+const IscsiPort = "3260"
+
+// ListISCSIPortals lists the iSCSI portals of the array with their target IQN, VLAN, ethernet port and SP
+func (c *UnityClientImpl) ListISCSIPortals(ctx context.Context) ([]types.IscsiPortal, error) {
+	portalResp := &types.ListIscsiPortal{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.IscsiPortalAction, IscsiPortalFields), nil, portalResp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list iSCSI portals %v", err)
+	}
+	return portalResp.Entries, nil
+}
+
+// ListFCPorts lists the FC ports of the array with their WWN, speed, SP and health
+func (c *UnityClientImpl) ListFCPorts(ctx context.Context) ([]types.FcPort, error) {
+	fcPortResp := &types.ListFcPort{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.FcPortAction, FcPortListFields), nil, fcPortResp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list FC ports %v", err)
+	}
+	return fcPortResp.Entries, nil
+}
+
+// ListEthernetPorts lists the ethernet ports of the array with their link state, speed, SP and health
+func (c *UnityClientImpl) ListEthernetPorts(ctx context.Context) ([]types.EthernetPort, error) {
+	ethernetPortResp := &types.ListEthernetPort{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.EthernetPortAction, EthernetPortFields), nil, ethernetPortResp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list ethernet ports %v", err)
+	}
+	return ethernetPortResp.Entries, nil
+}
+
+// GetTargetInfo gathers the iSCSI target portals, the iSCSI target IQNs of each SP and the FC target ports of the array,
+// ready for host-side login
+func (c *UnityClientImpl) GetTargetInfo(ctx context.Context) (*types.TargetInfo, error) {
+	log := c.log(ctx)
+	portals, err := c.ListISCSIPortals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ethernetPorts, err := c.ListEthernetPorts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fcPorts, err := c.ListFCPorts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	linkUp := make(map[string]bool, len(ethernetPorts))
+	for _, port := range ethernetPorts {
+		linkUp[port.EthernetPortContent.ID] = port.EthernetPortContent.IsLinkUp
+	}
+
+	targetInfo := &types.TargetInfo{ISCSITargetIQNs: make(map[string][]string)}
+	for _, portal := range portals {
+		content := portal.IscsiPortalContent
+		if content.IPAddress == "" {
+			continue
+		}
+		sp := content.EthernetPort.StorageProcessor.ID
+		targetInfo.ISCSITargets = append(targetInfo.ISCSITargets, types.ISCSITarget{
+			IQN:              content.IscsiNode.Name,
+			Portal:           net.JoinHostPort(content.IPAddress, IscsiPort),
+			StorageProcessor: sp,
+			EthernetPort:     content.EthernetPort.ID,
+			VlanID:           content.VlanID,
+			LinkUp:           linkUp[content.EthernetPort.ID],
+		})
+		if content.IscsiNode.Name != "" && !slices.Contains(targetInfo.ISCSITargetIQNs[sp], content.IscsiNode.Name) {
+			targetInfo.ISCSITargetIQNs[sp] = append(targetInfo.ISCSITargetIQNs[sp], content.IscsiNode.Name)
+		}
+	}
+
+	for _, port := range fcPorts {
+		content := port.FcPortContent
+		if content.Wwn == "" {
+			continue
+		}
+		targetInfo.FCTargets = append(targetInfo.FCTargets, types.FCTarget{
+			WWN:              content.Wwn,
+			WWPN:             portWWPN(content.Wwn),
+			StorageProcessor: content.StorageProcessor.ID,
+			Health:           content.Health,
+		})
+	}
+	log.Debugf("Found %d iSCSI targets and %d FC targets", len(targetInfo.ISCSITargets), len(targetInfo.FCTargets))
+	return targetInfo, nil
+}
+
+// portWWPN returns the WWPN of the given FC port WWN. Unity reports the port WWN as WWNN:WWPN.
+func portWWPN(wwn string) string {
+	parts := strings.Split(util.NormalizeInitiatorID(wwn), ":")
+	if len(parts) != 16 {
+		return wwn
+	}
+	return strings.Join(parts[8:], ":")
+}
@@ -0,0 +1,170 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/dell/gounity/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListISCSIPortals(t *testing.T) {
+	assert := assert.New(t)
+	fmt.Println("Begin - List iSCSI Portals Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/iscsiPortal/instances?fields="+IscsiPortalFields, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListIscsiPortal")).Return(nil).
+		Run(func(args mock.Arguments) {
+			resp := args.Get(5).(*types.ListIscsiPortal)
+			resp.Entries = []types.IscsiPortal{{IscsiPortalContent: types.IscsiPortalContent{ID: "if_4", IPAddress: "10.0.0.1", VlanID: 100}}}
+		}).Once()
+	portals, err := testConf.client.ListISCSIPortals(ctx)
+	assert.NoError(err)
+	assert.Len(portals, 1)
+	assert.Equal(100, portals[0].IscsiPortalContent.VlanID)
+
+	mockClient.On("DoWithHeaders", anyArgs...).Return(fmt.Errorf("API call error")).Once()
+	_, err = testConf.client.ListISCSIPortals(ctx)
+	assert.Error(err)
+
+	fmt.Println("List iSCSI Portals Test Successful")
+}
+
+func TestListFCPorts(t *testing.T) {
+	assert := assert.New(t)
+	fmt.Println("Begin - List FC Ports Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/fcPort/instances?fields="+FcPortListFields, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListFcPort")).Return(nil).
+		Run(func(args mock.Arguments) {
+			resp := args.Get(5).(*types.ListFcPort)
+			resp.Entries = []types.FcPort{{FcPortContent: types.FcPortContent{ID: "spa_fc4", Wwn: "50:06:01:60:C7:E0:01:DA:50:06:01:64:47:E0:01:DA", CurrentSpeed: 16}}}
+		}).Once()
+	ports, err := testConf.client.ListFCPorts(ctx)
+	assert.NoError(err)
+	assert.Len(ports, 1)
+	assert.Equal("spa_fc4", ports[0].FcPortContent.ID)
+
+	mockClient.On("DoWithHeaders", anyArgs...).Return(fmt.Errorf("API call error")).Once()
+	_, err = testConf.client.ListFCPorts(ctx)
+	assert.Error(err)
+
+	fmt.Println("List FC Ports Test Successful")
+}
+
+func TestListEthernetPorts(t *testing.T) {
+	assert := assert.New(t)
+	fmt.Println("Begin - List Ethernet Ports Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/ethernetPort/instances?fields="+EthernetPortFields, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListEthernetPort")).Return(nil).
+		Run(func(args mock.Arguments) {
+			resp := args.Get(5).(*types.ListEthernetPort)
+			resp.Entries = []types.EthernetPort{{EthernetPortContent: types.EthernetPortContent{ID: "spa_eth2", IsLinkUp: true}}}
+		}).Once()
+	ports, err := testConf.client.ListEthernetPorts(ctx)
+	assert.NoError(err)
+	assert.Len(ports, 1)
+	assert.True(ports[0].EthernetPortContent.IsLinkUp)
+
+	mockClient.On("DoWithHeaders", anyArgs...).Return(fmt.Errorf("API call error")).Once()
+	_, err = testConf.client.ListEthernetPorts(ctx)
+	assert.Error(err)
+
+	fmt.Println("List Ethernet Ports Test Successful")
+}
+
+func TestGetTargetInfo(t *testing.T) {
+	assert := assert.New(t)
+	fmt.Println("Begin - Get Target Info Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	ctx := context.Background()
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListIscsiPortal")).Return(nil).
+		Run(func(args mock.Arguments) {
+			resp := args.Get(5).(*types.ListIscsiPortal)
+			resp.Entries = []types.IscsiPortal{
+				{IscsiPortalContent: types.IscsiPortalContent{
+					ID:           "if_4",
+					IPAddress:    "10.0.0.1",
+					VlanID:       100,
+					EthernetPort: types.EthernetPortRef{ID: "spa_eth2", StorageProcessor: types.StorageResource{ID: "spa"}},
+					IscsiNode:    types.IscsiNodeRef{ID: "iscsinode_spa_eth2", Name: "iqn.1992-04.com.emc:cx.virt1234.a0"},
+				}},
+				{IscsiPortalContent: types.IscsiPortalContent{
+					ID:           "if_6",
+					IPAddress:    "10.0.1.1",
+					EthernetPort: types.EthernetPortRef{ID: "spa_eth2", StorageProcessor: types.StorageResource{ID: "spa"}},
+					IscsiNode:    types.IscsiNodeRef{ID: "iscsinode_spa_eth2", Name: "iqn.1992-04.com.emc:cx.virt1234.a0"},
+				}},
+				{IscsiPortalContent: types.IscsiPortalContent{
+					ID:           "if_7",
+					IPAddress:    "10.0.0.2",
+					EthernetPort: types.EthernetPortRef{ID: "spb_eth2", StorageProcessor: types.StorageResource{ID: "spb"}},
+					IscsiNode:    types.IscsiNodeRef{ID: "iscsinode_spb_eth2", Name: "iqn.1992-04.com.emc:cx.virt1234.b0"},
+				}},
+				{IscsiPortalContent: types.IscsiPortalContent{ID: "if_5"}},
+			}
+		}).Once()
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListEthernetPort")).Return(nil).
+		Run(func(args mock.Arguments) {
+			resp := args.Get(5).(*types.ListEthernetPort)
+			resp.Entries = []types.EthernetPort{{EthernetPortContent: types.EthernetPortContent{ID: "spa_eth2", IsLinkUp: true}}}
+		}).Once()
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListFcPort")).Return(nil).
+		Run(func(args mock.Arguments) {
+			resp := args.Get(5).(*types.ListFcPort)
+			resp.Entries = []types.FcPort{
+				{FcPortContent: types.FcPortContent{ID: "spa_fc4", Wwn: "50:06:01:60:c7:e0:01:da:50:06:01:64:47:e0:01:da", CurrentSpeed: 16, StorageProcessor: types.StorageResource{ID: "spa"}, Health: types.HealthContent{Value: 5}}},
+				{FcPortContent: types.FcPortContent{ID: "spb_fc4", Wwn: "5006016047E001DA5006016C47E001DA", StorageProcessor: types.StorageResource{ID: "spb"}}},
+			}
+		}).Once()
+
+	targetInfo, err := testConf.client.GetTargetInfo(ctx)
+	assert.NoError(err)
+	assert.Len(targetInfo.ISCSITargets, 3)
+	assert.Equal("iqn.1992-04.com.emc:cx.virt1234.a0", targetInfo.ISCSITargets[0].IQN)
+	assert.Equal("10.0.0.1:3260", targetInfo.ISCSITargets[0].Portal)
+	assert.Equal("spa", targetInfo.ISCSITargets[0].StorageProcessor)
+	assert.True(targetInfo.ISCSITargets[0].LinkUp)
+	assert.False(targetInfo.ISCSITargets[2].LinkUp)
+	assert.Equal(map[string][]string{
+		"spa": {"iqn.1992-04.com.emc:cx.virt1234.a0"},
+		"spb": {"iqn.1992-04.com.emc:cx.virt1234.b0"},
+	}, targetInfo.ISCSITargetIQNs)
+	assert.Len(targetInfo.FCTargets, 2)
+	assert.Equal("50:06:01:64:47:E0:01:DA", targetInfo.FCTargets[0].WWPN)
+	assert.Equal(5, targetInfo.FCTargets[0].Health.Value)
+	assert.Equal("50:06:01:6C:47:E0:01:DA", targetInfo.FCTargets[1].WWPN)
+
+	// Negative case: listing iSCSI portals fails
+	mockClient.On("DoWithHeaders", anyArgs...).Return(fmt.Errorf("API call error")).Once()
+	_, err = testConf.client.GetTargetInfo(ctx)
+	assert.Error(err)
+
+	fmt.Println("Get Target Info Test Successful")
+}
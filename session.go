@@ -0,0 +1,270 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dell/gounity/api"
+	"github.com/dell/gounity/types"
+)
+
+// DefaultKeepaliveInterval is the keepalive interval used when neither the options nor the array provide one
+const DefaultKeepaliveInterval = 5 * time.Minute
+
+// logoutTimeout bounds the logout request sent by Close
+const logoutTimeout = 30 * time.Second
+
+var errKeepaliveRunning = errors.New("session keepalive is already running")
+
+// KeepaliveOptions configures the background session keepalive
+type KeepaliveOptions struct {
+	// Interval is the delay between two keepalive requests. When zero, half of the idle timeout
+	// of the session is used, or DefaultKeepaliveInterval if the array does not report it.
+	Interval time.Duration
+	// MaxSessionAge makes the keepalive re-authenticate once the session is older, before the array expires it.
+	// Zero disables the proactive re-authentication.
+	MaxSessionAge time.Duration
+}
+
+// SessionStats describes the login session of the client
+type SessionStats struct {
+	Active    bool
+	StartedAt time.Time
+	Age       time.Duration
+	// Authentications counts the successful logins, including the re-authentications
+	Authentications   int
+	Keepalives        int
+	KeepaliveFailures int
+}
+
+// idleConnectionsCloser is implemented by API clients able to release their idle connections
+type idleConnectionsCloser interface {
+	CloseIdleConnections()
+}
+
+// session tracks the login session of the client and its keepalive
+type session struct {
+	sync.Mutex
+	startedAt         time.Time
+	authentications   int
+	keepalives        int
+	keepaliveFailures int
+	stopKeepalive     context.CancelFunc
+	keepaliveDone     chan struct{}
+}
+
+// sessionStarted records a successful login
+func (c *UnityClientImpl) sessionStarted(ctx context.Context) {
+	log := c.log(ctx)
+	c.session.Lock()
+	defer c.session.Unlock()
+	if !c.session.startedAt.IsZero() {
+		log.Infof("Unity session replaced after %s", time.Since(c.session.startedAt).Round(time.Second))
+	} else {
+		log.Info("Unity session started")
+	}
+	c.session.startedAt = time.Now()
+	c.session.authentications++
+}
+
+// loginCount returns the number of successful logins, to tell whether the session was replaced
+func (c *UnityClientImpl) loginCount() int {
+	c.session.Lock()
+	defer c.session.Unlock()
+	return c.session.authentications
+}
+
+// SessionStats returns the state of the login session and the keepalive counters
+func (c *UnityClientImpl) SessionStats() SessionStats {
+	c.session.Lock()
+	defer c.session.Unlock()
+	stats := SessionStats{
+		Active:            !c.session.startedAt.IsZero(),
+		StartedAt:         c.session.startedAt,
+		Authentications:   c.session.authentications,
+		Keepalives:        c.session.keepalives,
+		KeepaliveFailures: c.session.keepaliveFailures,
+	}
+	if stats.Active {
+		stats.Age = time.Since(stats.StartedAt)
+	}
+	return stats
+}
+
+// StartKeepalive keeps the login session alive in the background until StopKeepalive, Logout or Close is called,
+// or the context is done. The session is refreshed by a request at every interval, and re-authenticated when it
+// has expired or is older than MaxSessionAge.
+func (c *UnityClientImpl) StartKeepalive(ctx context.Context, opts KeepaliveOptions) error {
+	interval := opts.Interval
+	if interval == 0 {
+		interval = DefaultKeepaliveInterval
+		if idleTimeout, err := c.sessionIdleTimeout(ctx); err == nil && idleTimeout > 0 {
+			interval = idleTimeout / 2
+		}
+	}
+
+	c.session.Lock()
+	defer c.session.Unlock()
+	if c.session.stopKeepalive != nil {
+		return errKeepaliveRunning
+	}
+	keepaliveCtx, cancel := context.WithCancel(ctx)
+	c.session.stopKeepalive = cancel
+	c.session.keepaliveDone = make(chan struct{})
+	go c.keepalive(keepaliveCtx, interval, opts.MaxSessionAge, c.session.keepaliveDone)
+	return nil
+}
+
+// StopKeepalive stops the background keepalive and waits for it to return
+func (c *UnityClientImpl) StopKeepalive() {
+	c.session.Lock()
+	stop, done := c.session.stopKeepalive, c.session.keepaliveDone
+	c.session.stopKeepalive, c.session.keepaliveDone = nil, nil
+	c.session.Unlock()
+	if stop != nil {
+		stop()
+		<-done
+	}
+}
+
+func (c *UnityClientImpl) keepalive(ctx context.Context, interval, maxSessionAge time.Duration, done chan struct{}) {
+	log := c.log(ctx)
+	defer close(done)
+	// A keepalive stopped by its context can be started again without StopKeepalive
+	defer func() {
+		c.session.Lock()
+		if c.session.keepaliveDone == done {
+			c.session.stopKeepalive, c.session.keepaliveDone = nil, nil
+		}
+		c.session.Unlock()
+	}()
+	log.Debugf("Unity session keepalive started, interval %s", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("Unity session keepalive stopped")
+			return
+		case <-ticker.C:
+		}
+
+		var err error
+		if maxSessionAge > 0 && c.SessionStats().Age >= maxSessionAge {
+			log.Infof("Unity session is older than %s, re-authenticating", maxSessionAge)
+			err = c.reauthenticate(ctx)
+		} else {
+			// A request refreshes the idle timer, and re-authenticates if the session has expired
+			_, err = c.sessionIdleTimeout(ctx)
+		}
+
+		c.session.Lock()
+		c.session.keepalives++
+		if err != nil {
+			c.session.keepaliveFailures++
+		}
+		c.session.Unlock()
+		if err != nil && ctx.Err() == nil {
+			log.Warnf("Unity session keepalive failed: %v", err)
+		}
+	}
+}
+
+// reauthenticate replaces the login session under the login lock. The requests failing meanwhile with an expired
+// session wait for the lock and are retried with the new session, see reauthenticateAfter. The old session is
+// ended first so that it does not count against the session limit of the array.
+func (c *UnityClientImpl) reauthenticate(ctx context.Context) error {
+	c.loginMutex.Lock()
+	defer c.loginMutex.Unlock()
+	if err := c.endSession(ctx); err != nil {
+		return err
+	}
+	return c.authenticateLocked(ctx, nil)
+}
+
+// reauthenticateAfter logs in again after a request sent after the given number of logins failed with an expired
+// session, unless another login has replaced the session meanwhile
+func (c *UnityClientImpl) reauthenticateAfter(ctx context.Context, logins int) error {
+	c.loginMutex.Lock()
+	defer c.loginMutex.Unlock()
+	if c.loginCount() != logins {
+		return nil
+	}
+	return c.authenticateLocked(ctx, nil)
+}
+
+// sessionIdleTimeout gets the idle timeout of the current session
+func (c *UnityClientImpl) sessionIdleTimeout(ctx context.Context) (time.Duration, error) {
+	sessions := &types.ListLoginSession{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPILoginSessionInstancesURI, LoginSessionFields), nil, sessions)
+	if err != nil {
+		return 0, err
+	}
+	if len(sessions.Entries) == 0 {
+		return 0, nil
+	}
+	return time.Duration(sessions.Entries[0].LoginSessionContent.IdleTimeout) * time.Second, nil
+}
+
+// Logout stops the keepalive, ends the login session on the array and releases the idle connections.
+// An already expired session is not reported as an error.
+func (c *UnityClientImpl) Logout(ctx context.Context) error {
+	c.StopKeepalive()
+	defer c.closeIdleConnections()
+	return c.endSession(ctx)
+}
+
+// endSession ends the login session on the array
+func (c *UnityClientImpl) endSession(ctx context.Context) error {
+	log := c.log(ctx)
+	if c.api.GetToken() == "" {
+		return nil
+	}
+	err := c.api.DoWithHeaders(ctx, http.MethodPost, api.UnityAPILogoutURI, c.requestHeaders(), &types.LogoutParam{}, nil)
+	if e, ok := err.(*types.Error); ok && e.ErrorContent.HTTPStatusCode == http.StatusUnauthorized {
+		err = nil
+	}
+	if err != nil {
+		return fmt.Errorf("logout failed: %v", err)
+	}
+	c.api.SetToken("")
+
+	c.session.Lock()
+	if !c.session.startedAt.IsZero() {
+		log.Infof("Unity session ended after %s", time.Since(c.session.startedAt).Round(time.Second))
+	}
+	c.session.startedAt = time.Time{}
+	c.session.Unlock()
+	return nil
+}
+
+// Close logs out of the array. The client should not be used afterwards.
+func (c *UnityClientImpl) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), logoutTimeout)
+	defer cancel()
+	return c.Logout(ctx)
+}
+
+func (c *UnityClientImpl) closeIdleConnections() {
+	if closer, ok := c.api.(idleConnectionsCloser); ok {
+		closer.CloseIdleConnections()
+	}
+}
@@ -0,0 +1,182 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/dell/gounity/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newSessionTestClient returns a client with its own mock, logged in to the array
+func newSessionTestClient(t *testing.T) (*UnityClientImpl, *mocksapi.Client) {
+	mockClient := &mocksapi.Client{}
+	client := &UnityClientImpl{api: mockClient, configConnect: &ConfigConnect{}}
+	mockClient.On("SetToken", mock.Anything).Return()
+	mockClient.On("DoAndGetResponseBody", mock.Anything, "GET", "/api/types/loginSessionInfo", mock.Anything, mock.Anything).Return(
+		&http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(""))}, nil)
+	// Capabilities are not needed by the session tests
+	mockClient.On("DoAndGetResponseBody", mock.Anything, "GET", "/api/types/basicSystemInfo/instances", mock.Anything, mock.Anything).Return(nil, errors.New("unreachable"))
+	assert.NoError(t, client.Authenticate(context.Background(), &ConfigConnect{}))
+	return client, mockClient
+}
+
+func TestSessionKeepalive(t *testing.T) {
+	fmt.Println("Begin - Session Keepalive Test")
+	client, mockClient := newSessionTestClient(t)
+	ctx := context.Background()
+
+	// Registered first as the nil response of the logout cannot be matched against the typed response below
+	mockClient.On("DoWithHeaders", mock.Anything, "POST", "/api/types/loginSessionInfo/action/logout", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockClient.On("GetToken").Return("token")
+	keepalives := make(chan struct{}, 10)
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf("/api/types/loginSessionInfo/instances?fields=%s", LoginSessionFields), mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListLoginSession")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListLoginSession)
+		resp.Entries = []types.LoginSession{{LoginSessionContent: types.LoginSessionContent{ID: "session", IdleTimeout: 3600}}}
+		keepalives <- struct{}{}
+	})
+
+	stats := client.SessionStats()
+	assert.True(t, stats.Active)
+	assert.Equal(t, 1, stats.Authentications)
+
+	assert.NoError(t, client.StartKeepalive(ctx, KeepaliveOptions{Interval: time.Millisecond}))
+	assert.Error(t, client.StartKeepalive(ctx, KeepaliveOptions{Interval: time.Millisecond}))
+	<-keepalives
+	<-keepalives
+	client.StopKeepalive()
+	assert.GreaterOrEqual(t, client.SessionStats().Keepalives, 2)
+	assert.Zero(t, client.SessionStats().KeepaliveFailures)
+
+	// The keepalive re-authenticates sessions older than the maximum age, after ending them
+	assert.NoError(t, client.StartKeepalive(ctx, KeepaliveOptions{Interval: time.Millisecond, MaxSessionAge: time.Nanosecond}))
+	assert.Eventually(t, func() bool { return client.SessionStats().Authentications >= 2 }, time.Second, time.Millisecond)
+	client.StopKeepalive()
+
+	fmt.Println("Session Keepalive Test Successful")
+}
+
+func TestSessionReauthenticateLocked(t *testing.T) {
+	fmt.Println("Begin - Session Reauthenticate Locked Test")
+	client, mockClient := newSessionTestClient(t)
+	ctx := context.Background()
+
+	// The logout and the login of the re-authentication run under the login lock
+	mockClient.On("GetToken").Return("token")
+	mockClient.On("DoWithHeaders", mock.Anything, "POST", "/api/types/loginSessionInfo/action/logout", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(_ mock.Arguments) {
+		assert.False(t, client.loginMutex.TryLock())
+	}).Once()
+	assert.NoError(t, client.reauthenticate(ctx))
+	assert.Equal(t, 2, client.SessionStats().Authentications)
+	assert.True(t, client.loginMutex.TryLock())
+	client.loginMutex.Unlock()
+
+	// A failed logout keeps the session
+	mockClient.On("DoWithHeaders", mock.Anything, "POST", "/api/types/loginSessionInfo/action/logout", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error")).Once()
+	assert.Error(t, client.reauthenticate(ctx))
+	assert.Equal(t, 2, client.SessionStats().Authentications)
+
+	fmt.Println("Session Reauthenticate Locked Test Successful")
+}
+
+func TestSessionKeepaliveContextDone(t *testing.T) {
+	fmt.Println("Begin - Session Keepalive Context Done Test")
+	client, mockClient := newSessionTestClient(t)
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListLoginSession")).Return(nil)
+
+	// A keepalive whose context is done can be started again
+	ctx, cancel := context.WithCancel(context.Background())
+	assert.NoError(t, client.StartKeepalive(ctx, KeepaliveOptions{Interval: time.Millisecond}))
+	cancel()
+	assert.Eventually(t, func() bool {
+		return client.StartKeepalive(context.Background(), KeepaliveOptions{Interval: time.Millisecond}) == nil
+	}, time.Second, time.Millisecond)
+	client.StopKeepalive()
+
+	fmt.Println("Session Keepalive Context Done Test Successful")
+}
+
+func TestSessionExpiredRequests(t *testing.T) {
+	fmt.Println("Begin - Session Expired Requests Test")
+	client, mockClient := newSessionTestClient(t)
+	ctx := context.Background()
+	expired := &types.Error{ErrorContent: types.ErrorContent{HTTPStatusCode: http.StatusUnauthorized}}
+
+	// Concurrent requests failing with the same expired session log in once
+	var failed atomic.Int32
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/pool/instances", mock.Anything, mock.Anything, mock.Anything).Return(expired).Run(func(_ mock.Arguments) {
+		failed.Add(1)
+	}).Times(4)
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/pool/instances", mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(4)
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			assert.NoError(t, client.executeWithRetryAuthenticate(ctx, http.MethodGet, "/api/types/pool/instances", nil, nil))
+		}()
+	}
+	// The requests wait for the login lock until they all failed
+	client.loginMutex.Lock()
+	close(start)
+	assert.Eventually(t, func() bool { return failed.Load() == 4 }, time.Second, time.Millisecond)
+	client.loginMutex.Unlock()
+	wg.Wait()
+	assert.Equal(t, 2, client.SessionStats().Authentications)
+
+	fmt.Println("Session Expired Requests Test Successful")
+}
+
+func TestLogout(t *testing.T) {
+	fmt.Println("Begin - Logout Test")
+	client, mockClient := newSessionTestClient(t)
+	ctx := context.Background()
+
+	mockClient.On("GetToken").Return("token").Once()
+	mockClient.On("DoWithHeaders", mock.Anything, "POST", "/api/types/loginSessionInfo/action/logout", mock.Anything, &types.LogoutParam{}, mock.Anything).Return(nil).Once()
+	assert.NoError(t, client.Logout(ctx))
+	assert.False(t, client.SessionStats().Active)
+	mockClient.AssertCalled(t, "SetToken", "")
+
+	// Nothing is sent without a session
+	mockClient.On("GetToken").Return("").Once()
+	assert.NoError(t, client.Close())
+
+	// An expired session is already logged out
+	mockClient.On("GetToken").Return("token")
+	mockClient.On("DoWithHeaders", mock.Anything, "POST", "/api/types/loginSessionInfo/action/logout", mock.Anything, mock.Anything, mock.Anything).Return(
+		&types.Error{ErrorContent: types.ErrorContent{HTTPStatusCode: http.StatusUnauthorized}}).Once()
+	assert.NoError(t, client.Logout(ctx))
+
+	mockClient.On("DoWithHeaders", mock.Anything, "POST", "/api/types/loginSessionInfo/action/logout", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error")).Once()
+	assert.Error(t, client.Close())
+	mockClient.AssertExpectations(t)
+
+	fmt.Println("Logout Test Successful")
+}
@@ -94,7 +94,7 @@ func (c *UnityClientImpl) CreateSnapshotWithFsAccesType(ctx context.Context, sto
 
 // DeleteFilesystemAsSnapshot - Delete Snapshots acting as filesystem on array
 func (c *UnityClientImpl) DeleteFilesystemAsSnapshot(ctx context.Context, snapshotID string, sourceFs *types.Filesystem) error {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	deleteSourceFs := false
 	if strings.Contains(sourceFs.FileContent.Description, MarkFilesystemForDeletion) {
 		deleteSourceFs = true
@@ -122,7 +122,7 @@ func (c *UnityClientImpl) DeleteFilesystemAsSnapshot(ctx context.Context, snapsh
 // Returns:
 // - an error if delete snapshot fails
 func (c *UnityClientImpl) DeleteSnapshot(ctx context.Context, snapshotID string) error {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	if snapshotID == "" {
 		return errors.New("snapshot ID cannot be empty")
 	}
@@ -182,7 +182,7 @@ func (c *UnityClientImpl) ListSnapshots(ctx context.Context, startToken int, max
 
 // FindSnapshotByName - To find snapshot using snapshot-name
 func (c *UnityClientImpl) FindSnapshotByName(ctx context.Context, snapshotName string) (*types.Snapshot, error) {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	snapshotName, err := util.ValidateResourceName(snapshotName, api.MaxResourceNameLength)
 	if err != nil {
 		return nil, err
@@ -201,7 +201,7 @@ func (c *UnityClientImpl) FindSnapshotByName(ctx context.Context, snapshotName s
 
 // FindSnapshotByID - To find snapshot using snapshot-id
 func (c *UnityClientImpl) FindSnapshotByID(ctx context.Context, snapshotID string) (*types.Snapshot, error) {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	if snapshotID == "" {
 		return nil, errors.New("snapshot ID cannot be empty")
 	}
@@ -219,7 +219,7 @@ func (c *UnityClientImpl) FindSnapshotByID(ctx context.Context, snapshotID strin
 
 // ModifySnapshotAutoDeleteParameter - Modify Snapshot (currently used to disable auto-delete parameter)
 func (c *UnityClientImpl) ModifySnapshotAutoDeleteParameter(ctx context.Context, snapshotID string) error {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	if snapshotID == "" {
 		return errors.New("snapshot ID cannot be empty")
 	}
@@ -43,3 +43,164 @@ func (c *UnityClientImpl) FindStoragePoolByID(ctx context.Context, poolID string
 
 	return spResponse, nil
 }
+
+// RaidType is the RAID type of a RAID group in a storage pool
+type RaidType int
+
+// RAID types supported by Unity
+const (
+	RaidTypeDefault   RaidType = 0
+	RaidType5         RaidType = 1
+	RaidType0         RaidType = 2
+	RaidType1         RaidType = 3
+	RaidType3         RaidType = 4
+	RaidType10        RaidType = 7
+	RaidType6         RaidType = 10
+	RaidTypeAutomatic RaidType = 48879
+)
+
+// Pool alert threshold limits, in percent of used capacity, accepted by Unity
+const (
+	MinPoolAlertThreshold = 50
+	MaxPoolAlertThreshold = 84
+)
+
+// RaidGroupSpec holds the disks to be added to a storage pool as a RAID group
+type RaidGroupSpec struct {
+	DiskGroupID string
+	NumDisks    int
+	RaidType    RaidType
+	// StripeWidth is chosen by the array when zero
+	StripeWidth int
+}
+
+// CreateStoragePoolOptions holds the parameters used to create a storage pool
+type CreateStoragePoolOptions struct {
+	Name        string
+	Description string
+	RaidGroups  []RaidGroupSpec
+	// AlertThreshold is left to the array default when zero
+	AlertThreshold        int
+	FASTCacheEnabled      *bool
+	FASTVPScheduleEnabled *bool
+}
+
+// ModifyStoragePoolOptions holds the storage pool parameters to be modified. Empty values are left unchanged.
+type ModifyStoragePoolOptions struct {
+	Name                  string
+	Description           string
+	AlertThreshold        int
+	FASTCacheEnabled      *bool
+	FASTVPScheduleEnabled *bool
+}
+
+// ListStoragePools lists all the storage pools of the array
+func (c *UnityClientImpl) ListStoragePools(ctx context.Context) ([]types.StoragePool, error) {
+	poolResp := &types.ListStoragePools{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.PoolAction, StoragePoolFields), nil, poolResp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list storage pools %v", err)
+	}
+	return poolResp.Entries, nil
+}
+
+// CreateStoragePool creates a storage pool from the given RAID groups
+func (c *UnityClientImpl) CreateStoragePool(ctx context.Context, opts *CreateStoragePoolOptions) (*types.StoragePool, error) {
+	if opts == nil || len(opts.Name) == 0 {
+		return nil, errors.New("pool name shouldn't be empty")
+	}
+	raidGroups, err := raidGroupParameters(opts.RaidGroups)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePoolAlertThreshold(opts.AlertThreshold); err != nil {
+		return nil, err
+	}
+	poolReq := &types.StoragePoolCreateParam{
+		Name:                    opts.Name,
+		Description:             opts.Description,
+		AddRaidGroupParameters:  raidGroups,
+		AlertThreshold:          opts.AlertThreshold,
+		IsFASTCacheEnabled:      opts.FASTCacheEnabled,
+		IsFASTVpScheduleEnabled: opts.FASTVPScheduleEnabled,
+	}
+	poolResp := &types.StoragePool{}
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityAPIInstanceTypeResources, api.PoolAction), poolReq, poolResp)
+	if err != nil {
+		return nil, fmt.Errorf("create storage pool %s failed, error: %v", opts.Name, err)
+	}
+	return poolResp, nil
+}
+
+// ExpandStoragePool adds the given RAID groups to the storage pool
+func (c *UnityClientImpl) ExpandStoragePool(ctx context.Context, poolID string, raidGroups []RaidGroupSpec) error {
+	if len(poolID) == 0 {
+		return errors.New("pool Id cannot be empty")
+	}
+	params, err := raidGroupParameters(raidGroups)
+	if err != nil {
+		return err
+	}
+	poolReq := &types.StoragePoolModifyParam{
+		AddRaidGroupParameters: params,
+	}
+	err = c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyPoolURI, poolID), poolReq, nil)
+	if err != nil {
+		return fmt.Errorf("expand storage pool %s failed, error: %v", poolID, err)
+	}
+	return nil
+}
+
+// ModifyStoragePool modifies the name, description, alert threshold, FAST Cache and FAST VP schedule settings of the storage pool
+func (c *UnityClientImpl) ModifyStoragePool(ctx context.Context, poolID string, opts *ModifyStoragePoolOptions) error {
+	if len(poolID) == 0 {
+		return errors.New("pool Id cannot be empty")
+	}
+	if opts == nil {
+		return errors.New("modify storage pool options shouldn't be nil")
+	}
+	if err := validatePoolAlertThreshold(opts.AlertThreshold); err != nil {
+		return err
+	}
+	poolReq := &types.StoragePoolModifyParam{
+		Name:                    opts.Name,
+		Description:             opts.Description,
+		AlertThreshold:          opts.AlertThreshold,
+		IsFASTCacheEnabled:      opts.FASTCacheEnabled,
+		IsFASTVpScheduleEnabled: opts.FASTVPScheduleEnabled,
+	}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodPost, fmt.Sprintf(api.UnityModifyPoolURI, poolID), poolReq, nil)
+	if err != nil {
+		return fmt.Errorf("modify storage pool %s failed, error: %v", poolID, err)
+	}
+	return nil
+}
+
+func raidGroupParameters(raidGroups []RaidGroupSpec) ([]types.RaidGroupParameters, error) {
+	if len(raidGroups) == 0 {
+		return nil, errors.New("at least one RAID group is required")
+	}
+	params := make([]types.RaidGroupParameters, 0, len(raidGroups))
+	for _, raidGroup := range raidGroups {
+		if len(raidGroup.DiskGroupID) == 0 {
+			return nil, errors.New("disk group Id cannot be empty")
+		}
+		if raidGroup.NumDisks <= 0 {
+			return nil, fmt.Errorf("invalid number of disks %d for disk group %s", raidGroup.NumDisks, raidGroup.DiskGroupID)
+		}
+		params = append(params, types.RaidGroupParameters{
+			DiskGroup:   &types.DiskGroupID{ID: raidGroup.DiskGroupID},
+			NumDisks:    raidGroup.NumDisks,
+			RaidType:    int(raidGroup.RaidType),
+			StripeWidth: raidGroup.StripeWidth,
+		})
+	}
+	return params, nil
+}
+
+func validatePoolAlertThreshold(alertThreshold int) error {
+	if alertThreshold != 0 && (alertThreshold < MinPoolAlertThreshold || alertThreshold > MaxPoolAlertThreshold) {
+		return fmt.Errorf("alert threshold %d should be between %d and %d", alertThreshold, MinPoolAlertThreshold, MaxPoolAlertThreshold)
+	}
+	return nil
+}
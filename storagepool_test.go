@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/dell/gounity/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -68,7 +69,7 @@ func TestFindStoragePoolByNameTest(t *testing.T) {
 	ctx := context.Background()
 
 	// Mock setup for valid pool name
-	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", "/api/instances/pool/name:valid_pool_name?fields=id,name,description,sizeFree,sizeTotal,sizeUsed,sizeSubscribed,hasDataReductionEnabledLuns,hasDataReductionEnabledFs,isFASTCacheEnabled,type,isAllFlash,poolFastVP", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", "/api/instances/pool/name:valid_pool_name?fields=id,name,description,sizeFree,sizeTotal,sizeUsed,sizeSubscribed,hasDataReductionEnabledLuns,hasDataReductionEnabledFs,isFASTCacheEnabled,type,isAllFlash,poolFastVP,alertThreshold,isFASTVpScheduleEnabled,raidType,dataReductionSizeSaved,dataReductionPercent,dataReductionRatio,snapSizeUsed,tiers", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 
 	// Positive Case
 	storagePoolName := "valid_pool_name" // Ensure this is set to a valid name
@@ -78,7 +79,7 @@ func TestFindStoragePoolByNameTest(t *testing.T) {
 	assert.NotNil(pool, "Pool should not be nil")
 
 	// Mock setup for empty pool name
-	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", "/api/instances/pool/name:?fields=id,name,description,sizeFree,sizeTotal,sizeUsed,sizeSubscribed,hasDataReductionEnabledLuns,hasDataReductionEnabledFs,isFASTCacheEnabled,type,isAllFlash,poolFastVP", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", "/api/instances/pool/name:?fields=id,name,description,sizeFree,sizeTotal,sizeUsed,sizeSubscribed,hasDataReductionEnabledLuns,hasDataReductionEnabledFs,isFASTCacheEnabled,type,isAllFlash,poolFastVP,alertThreshold,isFASTVpScheduleEnabled,raidType,dataReductionSizeSaved,dataReductionPercent,dataReductionRatio,snapSizeUsed,tiers", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 
 	// Negative Case: Empty pool name
 	storagePoolNameTemp := ""
@@ -87,7 +88,7 @@ func TestFindStoragePoolByNameTest(t *testing.T) {
 	assert.Nil(pool, "Pool should be nil for empty name")
 
 	// Mock setup for invalid pool name
-	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", "/api/instances/pool/name:dummy_pool_name_1?fields=id,name,description,sizeFree,sizeTotal,sizeUsed,sizeSubscribed,hasDataReductionEnabledLuns,hasDataReductionEnabledFs,isFASTCacheEnabled,type,isAllFlash,poolFastVP", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("pool not found")).Once()
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", "/api/instances/pool/name:dummy_pool_name_1?fields=id,name,description,sizeFree,sizeTotal,sizeUsed,sizeSubscribed,hasDataReductionEnabledLuns,hasDataReductionEnabledFs,isFASTCacheEnabled,type,isAllFlash,poolFastVP,alertThreshold,isFASTVpScheduleEnabled,raidType,dataReductionSizeSaved,dataReductionPercent,dataReductionRatio,snapSizeUsed,tiers", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("pool not found")).Once()
 
 	// Negative Case: Invalid pool name
 	storagePoolNameTemp = "dummy_pool_name_1"
@@ -97,3 +98,163 @@ func TestFindStoragePoolByNameTest(t *testing.T) {
 
 	fmt.Println("Find Storage Pool by Name Test - Successful")
 }
+
+func TestListStoragePools(t *testing.T) {
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	assert := require.New(t)
+	fmt.Println("Begin - List Storage Pools Test")
+	ctx := context.Background()
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "GET", "/api/types/pool/instances?fields="+StoragePoolFields, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListStoragePools")).Return(nil).
+		Run(func(args mock.Arguments) {
+			resp := args.Get(5).(*types.ListStoragePools)
+			resp.Entries = []types.StoragePool{{StoragePoolContent: types.StoragePoolContent{ID: "pool_1"}}, {StoragePoolContent: types.StoragePoolContent{ID: "pool_2"}}}
+		}).Once()
+	pools, err := testConf.client.ListStoragePools(ctx)
+	assert.NoError(err)
+	assert.Len(pools, 2)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("list failed")).Once()
+	_, err = testConf.client.ListStoragePools(ctx)
+	assert.Error(err)
+
+	fmt.Println("List Storage Pools Test - Successful")
+}
+
+func TestCreateStoragePool(t *testing.T) {
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	assert := require.New(t)
+	fmt.Println("Begin - Create Storage Pool Test")
+	ctx := context.Background()
+	fastCache := true
+
+	opts := &CreateStoragePoolOptions{
+		Name:             "pool_1",
+		RaidGroups:       []RaidGroupSpec{{DiskGroupID: "dg_15", NumDisks: 5, RaidType: RaidType5}},
+		AlertThreshold:   70,
+		FASTCacheEnabled: &fastCache,
+	}
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/types/pool/instances", mock.Anything, mock.MatchedBy(func(req *types.StoragePoolCreateParam) bool {
+		return req.Name == "pool_1" && len(req.AddRaidGroupParameters) == 1 &&
+			req.AddRaidGroupParameters[0].DiskGroup.ID == "dg_15" && req.AddRaidGroupParameters[0].RaidType == 1 &&
+			req.AlertThreshold == 70 && *req.IsFASTCacheEnabled
+	}), mock.Anything).Return(nil).
+		Run(func(args mock.Arguments) {
+			resp := args.Get(5).(*types.StoragePool)
+			resp.StoragePoolContent.ID = "pool_3"
+		}).Once()
+	pool, err := testConf.client.CreateStoragePool(ctx, opts)
+	assert.NoError(err)
+	assert.Equal("pool_3", pool.StoragePoolContent.ID)
+
+	// Negative cases
+	_, err = testConf.client.CreateStoragePool(ctx, &CreateStoragePoolOptions{})
+	assert.Error(err)
+
+	_, err = testConf.client.CreateStoragePool(ctx, &CreateStoragePoolOptions{Name: "pool_1"})
+	assert.Error(err)
+
+	_, err = testConf.client.CreateStoragePool(ctx, &CreateStoragePoolOptions{Name: "pool_1", RaidGroups: []RaidGroupSpec{{DiskGroupID: "dg_15"}}})
+	assert.Error(err)
+
+	_, err = testConf.client.CreateStoragePool(ctx, &CreateStoragePoolOptions{Name: "pool_1", RaidGroups: opts.RaidGroups, AlertThreshold: 90})
+	assert.Error(err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("create failed")).Once()
+	_, err = testConf.client.CreateStoragePool(ctx, opts)
+	assert.Error(err)
+
+	fmt.Println("Create Storage Pool Test - Successful")
+}
+
+func TestExpandStoragePool(t *testing.T) {
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	assert := require.New(t)
+	fmt.Println("Begin - Expand Storage Pool Test")
+	ctx := context.Background()
+
+	raidGroups := []RaidGroupSpec{{DiskGroupID: "dg_16", NumDisks: 8, RaidType: RaidType6, StripeWidth: 8}}
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/instances/pool/pool_1/action/modify", mock.Anything, mock.MatchedBy(func(req *types.StoragePoolModifyParam) bool {
+		return len(req.AddRaidGroupParameters) == 1 && req.AddRaidGroupParameters[0].StripeWidth == 8 && req.AddRaidGroupParameters[0].RaidType == 10
+	}), mock.Anything).Return(nil).Once()
+	err := testConf.client.ExpandStoragePool(ctx, "pool_1", raidGroups)
+	assert.NoError(err)
+
+	// Negative cases
+	err = testConf.client.ExpandStoragePool(ctx, "", raidGroups)
+	assert.Error(err)
+
+	err = testConf.client.ExpandStoragePool(ctx, "pool_1", nil)
+	assert.Error(err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("expand failed")).Once()
+	err = testConf.client.ExpandStoragePool(ctx, "pool_1", raidGroups)
+	assert.Error(err)
+
+	fmt.Println("Expand Storage Pool Test - Successful")
+}
+
+func TestModifyStoragePool(t *testing.T) {
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	assert := require.New(t)
+	fmt.Println("Begin - Modify Storage Pool Test")
+	ctx := context.Background()
+	fastVPSchedule := false
+
+	opts := &ModifyStoragePoolOptions{AlertThreshold: 80, FASTVPScheduleEnabled: &fastVPSchedule}
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", mock.Anything, "POST", "/api/instances/pool/pool_1/action/modify", mock.Anything, mock.MatchedBy(func(req *types.StoragePoolModifyParam) bool {
+		return req.AlertThreshold == 80 && req.IsFASTVpScheduleEnabled != nil && !*req.IsFASTVpScheduleEnabled && req.IsFASTCacheEnabled == nil
+	}), mock.Anything).Return(nil).Once()
+	err := testConf.client.ModifyStoragePool(ctx, "pool_1", opts)
+	assert.NoError(err)
+
+	// Negative cases
+	err = testConf.client.ModifyStoragePool(ctx, "", opts)
+	assert.Error(err)
+
+	err = testConf.client.ModifyStoragePool(ctx, "pool_1", nil)
+	assert.Error(err)
+
+	err = testConf.client.ModifyStoragePool(ctx, "pool_1", &ModifyStoragePoolOptions{AlertThreshold: 10})
+	assert.Error(err)
+
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).On("DoWithHeaders", anyArgs...).Return(errors.New("modify failed")).Once()
+	err = testConf.client.ModifyStoragePool(ctx, "pool_1", opts)
+	assert.Error(err)
+
+	fmt.Println("Modify Storage Pool Test - Successful")
+}
+
+func TestStoragePoolAnalytics(t *testing.T) {
+	assert := require.New(t)
+	fmt.Println("Begin - Storage Pool Analytics Test")
+
+	pool := types.StoragePoolContent{
+		TotalCapacity:          1000,
+		FreeCapacity:           250,
+		SubscribedCapacity:     1500,
+		DataReductionSizeSaved: 300,
+		DataReductionRatio:     1.6,
+		Tiers: []types.PoolTier{
+			{Name: "Extreme Performance", TierType: 10, SizeTotal: 200, SizeUsed: 150, SizeFree: 50},
+			{Name: "Capacity", TierType: 30, SizeTotal: 800, SizeUsed: 600, SizeFree: 200},
+		},
+	}
+	assert.InDelta(25.0, pool.FreePercent(), 0.001)
+	assert.InDelta(1.5, pool.SubscriptionRatio(), 0.001)
+	saved, ratio := pool.DataReductionSavings()
+	assert.Equal(uint64(300), saved)
+	assert.InDelta(1.6, ratio, 0.001)
+	breakdown := pool.TierBreakdown()
+	assert.Len(breakdown, 2)
+	assert.InDelta(20.0, breakdown[0].PercentOfPool, 0.001)
+	assert.Equal(uint64(600), breakdown[1].UsedCapacity)
+
+	// Empty pool should not divide by zero
+	empty := types.StoragePoolContent{Tiers: []types.PoolTier{{Name: "Capacity"}}}
+	assert.Zero(empty.FreePercent())
+	assert.Zero(empty.SubscriptionRatio())
+	assert.Zero(empty.TierBreakdown()[0].PercentOfPool)
+
+	fmt.Println("Storage Pool Analytics Test - Successful")
+}
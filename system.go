@@ -0,0 +1,102 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/dell/gounity/api"
+	"github.com/dell/gounity/types"
+)
+
+// systemInfoCache holds the identity of the array, which does not change for the lifetime of the client
+type systemInfoCache struct {
+	sync.Mutex
+	basic *types.Content
+	info  *types.SystemInfo
+}
+
+// GetSystemInfo gets the identity of the array: model, name and versions from basicSystemInfo,
+// serial number, UUID base, platform and MAC address from the system resource, and the installed features.
+// The result is fetched once and cached for the lifetime of the client, a copy is returned at every call.
+func (c *UnityClientImpl) GetSystemInfo(ctx context.Context) (*types.SystemInfo, error) {
+	log := c.log(ctx)
+	c.systemInfo.Lock()
+	info, basic := c.systemInfo.info, c.systemInfo.basic
+	c.systemInfo.Unlock()
+	if info != nil {
+		return cloneSystemInfo(info), nil
+	}
+
+	if basic == nil {
+		basicSystemInfo, err := c.basicSystemInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		basic = &basicSystemInfo.Entries[0].Content
+	}
+
+	systems := &types.ListSystem{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.SystemAction, SystemFields), nil, systems)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get system info: %v", err)
+	}
+	if len(systems.Entries) == 0 {
+		return nil, errors.New("unable to get system info: no system returned")
+	}
+	system := systems.Entries[0].SystemContent
+
+	features, err := c.ListFeatures(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info = &types.SystemInfo{
+		ID:                 system.ID,
+		Name:               basic.Name,
+		Model:              basic.Model,
+		SoftwareVersion:    basic.SoftwareVersion,
+		APIVersion:         basic.APIVersion,
+		EarliestAPIVersion: basic.EarliestAPIVersion,
+		SerialNumber:       system.SerialNumber,
+		UUIDBase:           system.UUIDBase,
+		Platform:           system.Platform,
+		MacAddress:         system.MacAddress,
+	}
+	for _, feature := range features {
+		info.Features = append(info.Features, feature.FeatureContent)
+	}
+	log.Debugf("System info: model %s, software version %s, serial number %s", info.Model, info.SoftwareVersion, info.SerialNumber)
+
+	c.systemInfo.Lock()
+	c.systemInfo.info = info
+	c.systemInfo.Unlock()
+	if info.SerialNumber != "" {
+		c.arrayID.Store(&info.SerialNumber)
+	}
+	return cloneSystemInfo(info), nil
+}
+
+// cloneSystemInfo copies the cached system info so that callers cannot modify the cache
+func cloneSystemInfo(info *types.SystemInfo) *types.SystemInfo {
+	clone := *info
+	clone.Features = slices.Clone(info.Features)
+	return &clone
+}
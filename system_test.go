@@ -0,0 +1,75 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/dell/gounity/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetSystemInfo(t *testing.T) {
+	fmt.Println("Begin - Get System Info Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+	client := testConf.client.(*UnityClientImpl)
+	client.systemInfo.basic, client.systemInfo.info = nil, nil
+	client.licenseCache.features = nil
+	defer func() { client.systemInfo.basic, client.systemInfo.info = nil, nil }()
+	ctx := context.Background()
+
+	body := `{"entries":[{"content":{"id":"0","model":"Unity 480F","name":"array-1","softwareVersion":"5.4.0","apiVersion":"5.4","earliestApiVersion":"4.0"}}]}`
+	mockClient.On("DoAndGetResponseBody", mock.Anything, "GET", "/api/types/basicSystemInfo/instances", mock.Anything, mock.Anything).Return(
+		&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil).Once()
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf("/api/types/system/instances?fields=%s", SystemFields), mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListSystem")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListSystem)
+		resp.Entries = []types.System{{SystemContent: types.SystemContent{ID: "0", SerialNumber: "APM00123456789", UUIDBase: 1234, Platform: "Platform4", MacAddress: "00:60:16:00:00:01"}}}
+	}).Once()
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf("/api/types/feature/instances?fields=%s", FeatureFields), mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListFeature")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListFeature)
+		resp.Entries = []types.Feature{{FeatureContent: types.FeatureContent{ID: "SNAP", Name: "Snapshots", State: 2}}}
+	}).Once()
+
+	info, err := testConf.client.GetSystemInfo(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "Unity 480F", info.Model)
+	assert.Equal(t, "5.4.0", info.SoftwareVersion)
+	assert.Equal(t, "APM00123456789", info.SerialNumber)
+	assert.Len(t, info.Features, 1)
+
+	// A copy of the cached info is returned without calling the array
+	info.Features[0].Name = "changed"
+	cached, err := testConf.client.GetSystemInfo(ctx)
+	assert.NoError(t, err)
+	assert.NotSame(t, info, cached)
+	assert.NotEqual(t, "changed", cached.Features[0].Name)
+
+	// Negative case
+	client.systemInfo.info = nil
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListSystem")).Return(errors.New("error")).Once()
+	_, err = testConf.client.GetSystemInfo(ctx)
+	assert.Error(t, err)
+
+	fmt.Println("Get System Info Test Successful")
+}
@@ -0,0 +1,125 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/dell/gounity/api"
+	"github.com/dell/gounity/types"
+)
+
+// SystemLimitID is the ID of a systemLimit instance
+type SystemLimitID string
+
+// System limit IDs
+const (
+	MaxLUNSizeLimit          SystemLimitID = "Limit_MaxLUNSize"
+	MaxLUNsLimit             SystemLimitID = "Limit_MaxLUNs"
+	MaxSnapshotsPerLUNLimit  SystemLimitID = "Limit_MaxSnapsPerLUN"
+	MaxSnapshotsLimit        SystemLimitID = "Limit_MaxSnaps"
+	MaxHostsLimit            SystemLimitID = "Limit_MaxHosts"
+	MaxFilesystemsLimit      SystemLimitID = "Limit_MaxFileSystems"
+	MaxFilesystemSizeLimit   SystemLimitID = "Limit_MaxFileSystemSize"
+	MaxNFSSharesLimit        SystemLimitID = "Limit_MaxNFSShares"
+	MaxNASServersLimit       SystemLimitID = "Limit_MaxNASServers"
+	MaxStoragePoolsLimit     SystemLimitID = "Limit_MaxPools"
+	MaxHostInitiatorsLimit   SystemLimitID = "Limit_MaxInitiators"
+	MaxConsistencyGroupLimit SystemLimitID = "Limit_MaxConsistencyGroups"
+)
+
+// SystemLimitUnit is the unit of a system limit
+type SystemLimitUnit int
+
+// System limit units
+const (
+	SystemLimitUnitOther SystemLimitUnit = 0
+	SystemLimitUnitCount SystemLimitUnit = 1
+	SystemLimitUnitBytes SystemLimitUnit = 2
+)
+
+// String returns the name of the unit
+func (u SystemLimitUnit) String() string {
+	switch u {
+	case SystemLimitUnitCount:
+		return "count"
+	case SystemLimitUnitBytes:
+		return "bytes"
+	default:
+		return "other"
+	}
+}
+
+// systemLimitUsageResources holds the resource type whose instances are counted against each limit
+var systemLimitUsageResources = map[SystemLimitID]string{
+	MaxLUNsLimit:         api.LunAction,
+	MaxSnapshotsLimit:    api.SnapAction,
+	MaxHostsLimit:        api.HostAction,
+	MaxFilesystemsLimit:  api.FileSystemAction,
+	MaxNFSSharesLimit:    api.NfsShareAction,
+	MaxNASServersLimit:   api.NasServerAction,
+	MaxStoragePoolsLimit: api.PoolAction,
+}
+
+// ListSystemLimits lists all the system limits of the array with their decoded unit. Usage is left nil on every limit,
+// as counting it costs one request per limit, it is only set by GetSystemLimit.
+func (c *UnityClientImpl) ListSystemLimits(ctx context.Context) ([]types.SystemLimit, error) {
+	limits := &types.ListSystemLimit{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.SystemLimitAction, SystemLimitFields), nil, limits)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list system limits: %v", err)
+	}
+	for i := range limits.Entries {
+		limits.Entries[i].SystemLimitContent.UnitName = SystemLimitUnit(limits.Entries[i].SystemLimitContent.Unit).String()
+	}
+	return limits.Entries, nil
+}
+
+// GetSystemLimit gets a system limit by its ID with its decoded unit, and its current usage when the client can count it
+func (c *UnityClientImpl) GetSystemLimit(ctx context.Context, limitID SystemLimitID) (*types.SystemLimit, error) {
+	if limitID == "" {
+		return nil, errors.New("system limit ID shouldn't be empty")
+	}
+	limit := &types.SystemLimit{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIGetResourceWithFieldsURI, api.SystemLimitAction, limitID, SystemLimitFields), nil, limit)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find system limit by ID %s: %v", limitID, err)
+	}
+	limit.SystemLimitContent.UnitName = SystemLimitUnit(limit.SystemLimitContent.Unit).String()
+	if err := c.countSystemLimitUsage(ctx, &limit.SystemLimitContent); err != nil {
+		return nil, err
+	}
+	return limit, nil
+}
+
+// countSystemLimitUsage fills the current usage of a system limit counting instances of a single resource type
+func (c *UnityClientImpl) countSystemLimitUsage(ctx context.Context, limit *types.SystemLimitContent) error {
+	log := c.log(ctx)
+	resource, ok := systemLimitUsageResources[SystemLimitID(limit.ID)]
+	if !ok {
+		return nil
+	}
+	count := &types.EntryCount{}
+	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIInstanceTypeCountURI, resource), nil, count)
+	if err != nil {
+		return fmt.Errorf("unable to count %s instances for system limit %s: %v", resource, limit.ID, err)
+	}
+	log.Debugf("System limit %s: %d of %d", limit.ID, count.EntryCount, limit.LimitValue)
+	limit.Usage = &count.EntryCount
+	return nil
+}
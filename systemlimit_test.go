@@ -0,0 +1,132 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package gounity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	mocksapi "github.com/dell/gounity/mocks/api"
+	"github.com/dell/gounity/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListSystemLimits(t *testing.T) {
+	fmt.Println("Begin - List System Limits Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).Calls = nil
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+	ctx := context.Background()
+
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf("/api/types/systemLimit/instances?fields=%s", SystemLimitFields), mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListSystemLimit")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.ListSystemLimit)
+		resp.Entries = []types.SystemLimit{
+			{SystemLimitContent: types.SystemLimitContent{ID: string(MaxLUNSizeLimit), Unit: int(SystemLimitUnitBytes), LimitValue: 281474976710656}},
+			{SystemLimitContent: types.SystemLimitContent{ID: string(MaxHostsLimit), Unit: int(SystemLimitUnitCount), LimitValue: 1000}},
+		}
+	}).Once()
+	limits, err := testConf.client.ListSystemLimits(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, limits, 2)
+	assert.Equal(t, "bytes", limits[0].SystemLimitContent.UnitName)
+	assert.Equal(t, "count", limits[1].SystemLimitContent.UnitName)
+	// Usage is only counted by GetSystemLimit
+	assert.Nil(t, limits[1].SystemLimitContent.Usage)
+	mockClient.AssertNotCalled(t, "DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.EntryCount"))
+
+	// Negative case
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.ListSystemLimit")).Return(errors.New("error")).Once()
+	_, err = testConf.client.ListSystemLimits(ctx)
+	assert.Error(t, err)
+
+	fmt.Println("List System Limits Test Successful")
+}
+
+func TestGetSystemLimit(t *testing.T) {
+	fmt.Println("Begin - Get System Limit Test")
+	testConf.client.(*UnityClientImpl).api.(*mocksapi.Client).ExpectedCalls = nil
+	mockClient := testConf.client.(*UnityClientImpl).api.(*mocksapi.Client)
+	ctx := context.Background()
+
+	_, err := testConf.client.GetSystemLimit(ctx, "")
+	assert.Error(t, err)
+
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", fmt.Sprintf("/api/instances/systemLimit/Limit_MaxFileSystems?fields=%s", SystemLimitFields), mock.Anything, mock.Anything, mock.AnythingOfType("*types.SystemLimit")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.SystemLimit)
+		resp.SystemLimitContent = types.SystemLimitContent{ID: string(MaxFilesystemsLimit), Unit: int(SystemLimitUnitCount), LimitValue: 1000}
+	}).Once()
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", "/api/types/filesystem/instances?fields=id&per_page=1&with_entrycount=true", mock.Anything, mock.Anything, mock.AnythingOfType("*types.EntryCount")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.EntryCount)
+		resp.EntryCount = 7
+	}).Once()
+	limit, err := testConf.client.GetSystemLimit(ctx, MaxFilesystemsLimit)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1000), limit.SystemLimitContent.LimitValue)
+	assert.Equal(t, uint64(7), *limit.SystemLimitContent.Usage)
+
+	// Negative cases
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.SystemLimit")).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*types.SystemLimit)
+		resp.SystemLimitContent = types.SystemLimitContent{ID: string(MaxHostsLimit)}
+	}).Once()
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.EntryCount")).Return(errors.New("error")).Once()
+	_, err = testConf.client.GetSystemLimit(ctx, MaxHostsLimit)
+	assert.Error(t, err)
+
+	mockClient.On("DoWithHeaders", mock.Anything, "GET", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*types.SystemLimit")).Return(errors.New("error")).Once()
+	_, err = testConf.client.GetSystemLimit(ctx, MaxLUNSizeLimit)
+	assert.Error(t, err)
+
+	fmt.Println("Get System Limit Test Successful")
+}
+
+// systemLimitInstances is a /api/types/systemLimit/instances response body
+const systemLimitInstances = `{
+  "@base": "https://unity/api/types/systemLimit/instances?fields=id,name,unit,limitValue,thresholdValue",
+  "entryCount": 3,
+  "entries": [
+    {"content": {"id": "Limit_MaxLUNSize", "name": "Max LUN size", "unit": 2, "limitValue": 281474976710656, "thresholdValue": 0}},
+    {"content": {"id": "Limit_MaxHosts", "name": "Max hosts", "unit": 1, "limitValue": 1000, "thresholdValue": 950}},
+    {"content": {"id": "Limit_MaxFileSystems", "name": "Max file systems", "unit": 1, "limitValue": 1000, "thresholdValue": 950}}
+  ]
+}`
+
+func TestSystemLimitFixture(t *testing.T) {
+	fmt.Println("Begin - System Limit Fixture Test")
+	limits := &types.ListSystemLimit{}
+	assert.NoError(t, json.Unmarshal([]byte(systemLimitInstances), limits))
+	assert.Len(t, limits.Entries, 3)
+
+	expected := []struct {
+		id       SystemLimitID
+		unit     SystemLimitUnit
+		resource string
+	}{
+		{MaxLUNSizeLimit, SystemLimitUnitBytes, ""},
+		{MaxHostsLimit, SystemLimitUnitCount, "host"},
+		{MaxFilesystemsLimit, SystemLimitUnitCount, "filesystem"},
+	}
+	for i, e := range expected {
+		content := limits.Entries[i].SystemLimitContent
+		assert.Equal(t, string(e.id), content.ID)
+		assert.Equal(t, e.unit, SystemLimitUnit(content.Unit))
+		assert.Equal(t, e.resource, systemLimitUsageResources[e.id])
+	}
+	fmt.Println("System Limit Fixture Test Successful")
+}
@@ -176,6 +176,49 @@ type LunHostAccessParameters struct {
 	HostAccess *[]HostAccess `json:"hostAccess,omitempty"`
 }
 
+// DiskGroupID Struct to capture Disk group ID
+type DiskGroupID struct {
+	ID string `json:"id"`
+}
+
+// RaidGroupParameters Struct to capture the RAID group parameters used to create or expand a pool
+type RaidGroupParameters struct {
+	DiskGroup   *DiskGroupID `json:"dskGroup"`
+	NumDisks    int          `json:"numDisks"`
+	RaidType    int          `json:"raidType"`
+	StripeWidth int          `json:"stripeWidth,omitempty"`
+}
+
+// StoragePoolCreateParam Struct to capture Storage pool create parameters
+type StoragePoolCreateParam struct {
+	Name                    string                `json:"name"`
+	Description             string                `json:"description,omitempty"`
+	AddRaidGroupParameters  []RaidGroupParameters `json:"addRaidGroupParameters"`
+	AlertThreshold          int                   `json:"alertThreshold,omitempty"`
+	IsFASTCacheEnabled      *bool                 `json:"isFASTCacheEnabled,omitempty"`
+	IsFASTVpScheduleEnabled *bool                 `json:"isFASTVpScheduleEnabled,omitempty"`
+}
+
+// StoragePoolModifyParam Struct to capture Storage pool modify and expand parameters
+type StoragePoolModifyParam struct {
+	Name                    string                `json:"name,omitempty"`
+	Description             string                `json:"description,omitempty"`
+	AddRaidGroupParameters  []RaidGroupParameters `json:"addRaidGroupParameters,omitempty"`
+	AlertThreshold          int                   `json:"alertThreshold,omitempty"`
+	IsFASTCacheEnabled      *bool                 `json:"isFASTCacheEnabled,omitempty"`
+	IsFASTVpScheduleEnabled *bool                 `json:"isFASTVpScheduleEnabled,omitempty"`
+}
+
+// LogoutParam Struct to capture the logout parameters
+type LogoutParam struct {
+	LocalCleanupOnly bool `json:"localCleanupOnly"`
+}
+
+// AlertModifyParam Struct to capture Alert modify parameters
+type AlertModifyParam struct {
+	IsAcknowledged bool `json:"isAcknowledged"`
+}
+
 // HostCreateParam Struct to capture Host Request
 type HostCreateParam struct {
 	Type        string   `json:"type"`
@@ -185,6 +228,13 @@ type HostCreateParam struct {
 	Tenant      *Tenants `json:"tenant,omitempty"`
 }
 
+// HostModifyParam Struct to capture Host modify parameters
+type HostModifyParam struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	OsType      string `json:"osType,omitempty"`
+}
+
 // HostIDContent Struct to capture Host ID Content
 type HostIDContent struct {
 	ID string `json:"id"`
@@ -205,7 +255,21 @@ type HostInitiatorCreateParam struct {
 
 // HostInitiatorModifyParam Struct to capture Host Initiator modify parameters
 type HostInitiatorModifyParam struct {
-	HostIDContent *HostIDContent `json:"host"`
+	HostIDContent *HostIDContent `json:"host,omitempty"`
+	IsIgnored     *bool          `json:"isIgnored,omitempty"`
+}
+
+// HostInitiatorCHAPModifyParam Struct to capture Host Initiator CHAP modify parameters
+type HostInitiatorCHAPModifyParam struct {
+	ChapUserName   string `json:"chapUserName"`
+	ChapSecret     string `json:"chapSecret"`
+	ChapSecretType int    `json:"chapSecretType"`
+}
+
+// IscsiSettingsModifyParam Struct to capture iSCSI settings modify parameters used for mutual CHAP
+type IscsiSettingsModifyParam struct {
+	ReverseCHAPUserName string `json:"reverseCHAPUserName"`
+	ReverseCHAPSecret   string `json:"reverseCHAPSecret"`
 }
 
 // HostAccess Struct to capture Host access parameters
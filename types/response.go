@@ -38,6 +38,82 @@ type StoragePoolContent struct {
 	Type                        int8       `json:"type"`
 	IsAllFlash                  bool       `json:"isAllFlash"`
 	PoolFastVP                  PoolFastVP `json:"poolFastVP"`
+	AlertThreshold              int        `json:"alertThreshold"`
+	IsFASTVpScheduleEnabled     bool       `json:"isFASTVpScheduleEnabled"`
+	RaidType                    int        `json:"raidType"`
+	DataReductionSizeSaved      uint64     `json:"dataReductionSizeSaved"`
+	DataReductionPercent        int        `json:"dataReductionPercent"`
+	DataReductionRatio          float64    `json:"dataReductionRatio"`
+	SnapSizeUsed                uint64     `json:"snapSizeUsed"`
+	Tiers                       []PoolTier `json:"tiers,omitempty"`
+}
+
+// PoolTier struct to capture a tier of a pool
+type PoolTier struct {
+	TierType    int    `json:"tierType"`
+	Name        string `json:"name"`
+	RaidType    int    `json:"raidType"`
+	StripeWidth int    `json:"stripeWidth"`
+	DiskCount   int    `json:"diskCount"`
+	SizeTotal   uint64 `json:"sizeTotal"`
+	SizeUsed    uint64 `json:"sizeUsed"`
+	SizeFree    uint64 `json:"sizeFree"`
+}
+
+// TierCapacity describes the capacity a single tier contributes to a pool
+type TierCapacity struct {
+	Name          string
+	TierType      int
+	TotalCapacity uint64
+	UsedCapacity  uint64
+	FreeCapacity  uint64
+	// PercentOfPool is the share of the pool total capacity provided by the tier
+	PercentOfPool float64
+}
+
+// FreePercent returns the free capacity of the pool as a percentage of its total capacity
+func (p StoragePoolContent) FreePercent() float64 {
+	if p.TotalCapacity == 0 {
+		return 0
+	}
+	return float64(p.FreeCapacity) * 100 / float64(p.TotalCapacity)
+}
+
+// SubscriptionRatio returns the subscribed capacity of the pool divided by its total capacity. A value above 1 means the pool is oversubscribed.
+func (p StoragePoolContent) SubscriptionRatio() float64 {
+	if p.TotalCapacity == 0 {
+		return 0
+	}
+	return float64(p.SubscribedCapacity) / float64(p.TotalCapacity)
+}
+
+// DataReductionSavings returns the capacity saved by data reduction in bytes and the data reduction ratio reported by the array
+func (p StoragePoolContent) DataReductionSavings() (uint64, float64) {
+	return p.DataReductionSizeSaved, p.DataReductionRatio
+}
+
+// TierBreakdown returns the capacity of each tier of the pool
+func (p StoragePoolContent) TierBreakdown() []TierCapacity {
+	breakdown := make([]TierCapacity, 0, len(p.Tiers))
+	for _, tier := range p.Tiers {
+		tierCapacity := TierCapacity{
+			Name:          tier.Name,
+			TierType:      tier.TierType,
+			TotalCapacity: tier.SizeTotal,
+			UsedCapacity:  tier.SizeUsed,
+			FreeCapacity:  tier.SizeFree,
+		}
+		if p.TotalCapacity != 0 {
+			tierCapacity.PercentOfPool = float64(tier.SizeTotal) * 100 / float64(p.TotalCapacity)
+		}
+		breakdown = append(breakdown, tierCapacity)
+	}
+	return breakdown
+}
+
+// ListStoragePools Struct to capture the response of StoragePool list
+type ListStoragePools struct {
+	Entries []StoragePool `json:"entries"`
 }
 
 // PoolFastVP struct to capture fastvp property of pool
@@ -50,6 +126,7 @@ type PoolFastVP struct {
 
 // ListVolumes Struct to capture the response of StorageResource response
 type ListVolumes struct {
+	Links   []Link   `json:"links"`
 	Volumes []Volume `json:"entries"`
 }
 
@@ -78,6 +155,8 @@ type VolumeContent struct {
 	TieringPolicy          int                  `json:"tieringPolicy,omitempty"`
 	ParentVolume           StorageResource      `json:"originalParentLun,omitempty"`
 	Health                 HealthContent        `json:"health,omitempty"`
+	SnapsSizeAllocated     uint64               `json:"snapsSizeAllocated,omitempty"`
+	DataReductionSizeSaved uint64               `json:"dataReductionSizeSaved,omitempty"`
 }
 
 // ParentSnap to capture Source Snapshot ID
@@ -145,20 +224,113 @@ type Content struct {
 	EarliestAPIVersion string `json:"earliestApiVersion"`
 }
 
+// System struct to capture the system object
+type System struct {
+	SystemContent SystemContent `json:"content"`
+}
+
+// SystemContent struct to capture the system parameters
+type SystemContent struct {
+	ID           string        `json:"id"`
+	Name         string        `json:"name"`
+	Model        string        `json:"model"`
+	SerialNumber string        `json:"serialNumber"`
+	UUIDBase     int           `json:"uuidBase"`
+	Platform     string        `json:"platform"`
+	MacAddress   string        `json:"macAddress"`
+	Health       HealthContent `json:"health,omitempty"`
+}
+
+// ListSystem struct to capture the system list
+type ListSystem struct {
+	Entries []System `json:"entries"`
+}
+
+// Feature struct to capture the feature object
+type Feature struct {
+	FeatureContent FeatureContent `json:"content"`
+}
+
+// FeatureContent struct to capture the feature parameters
+type FeatureContent struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	State  int    `json:"state"`
+	Reason int    `json:"reason"`
+}
+
+// ListFeature struct to capture the feature list
+type ListFeature struct {
+	Entries []Feature `json:"entries"`
+}
+
+// SystemInfo struct to capture the identity of the array
+type SystemInfo struct {
+	ID                 string
+	Name               string
+	Model              string
+	SoftwareVersion    string
+	APIVersion         string
+	EarliestAPIVersion string
+	SerialNumber       string
+	UUIDBase           int
+	Platform           string
+	MacAddress         string
+	Features           []FeatureContent
+}
+
 // Host struct to capture host object
 type Host struct {
 	HostContent HostContent `json:"content"`
 }
 
+// ListHost struct to capture host list
+type ListHost struct {
+	Links []Link `json:"links"`
+	Hosts []Host `json:"entries"`
+}
+
 // HostContent struct to capture host parameters
 type HostContent struct {
-	ID              string       `json:"id"`
-	Name            string       `json:"name,omitempty"`
-	Description     string       `json:"description,omitempty"`
-	FcInitiators    []Initiators `json:"fcHostInitiators,omitempty"`
-	IscsiInitiators []Initiators `json:"iscsiHostInitiators,omitempty"`
-	IPPorts         []IPPorts    `json:"hostIPPorts,omitempty"`
-	Address         string       `json:"address,omitempty"`
+	ID              string        `json:"id"`
+	Name            string        `json:"name,omitempty"`
+	Description     string        `json:"description,omitempty"`
+	Type            int           `json:"type,omitempty"`
+	OsType          string        `json:"osType,omitempty"`
+	Tenant          TenantContent `json:"tenant,omitempty"`
+	Health          HealthContent `json:"health,omitempty"`
+	HostLUNs        []HostLUNID   `json:"hostLUNs,omitempty"`
+	FcInitiators    []Initiators  `json:"fcHostInitiators,omitempty"`
+	IscsiInitiators []Initiators  `json:"iscsiHostInitiators,omitempty"`
+	IPPorts         []IPPorts     `json:"hostIPPorts,omitempty"`
+	Address         string        `json:"address,omitempty"`
+}
+
+// HostLUNID struct to capture HostLUN ID
+type HostLUNID struct {
+	ID string `json:"id"`
+}
+
+// ListHostLUN struct to capture host LUN list
+type ListHostLUN struct {
+	Links    []Link    `json:"links"`
+	HostLUNs []HostLUN `json:"entries"`
+}
+
+// HostLUN struct to capture host LUN object
+type HostLUN struct {
+	HostLUNContent HostLUNContent `json:"content"`
+}
+
+// HostLUNContent struct to capture host LUN parameters
+type HostLUNContent struct {
+	ID         string          `json:"id"`
+	Host       StorageResource `json:"host,omitempty"`
+	Type       int             `json:"type"`
+	HLU        int             `json:"hlu"`
+	Lun        StorageResource `json:"lun,omitempty"`
+	Snap       StorageResource `json:"snap,omitempty"`
+	IsReadOnly bool            `json:"isReadOnly"`
 }
 
 // Initiators struct to capture Initiator ID
@@ -285,6 +457,8 @@ type FileContent struct {
 	ID                     string        `json:"id"`
 	Name                   string        `json:"name,omitempty"`
 	SizeTotal              uint64        `json:"sizeTotal,omitempty"`
+	SizeUsed               uint64        `json:"sizeUsed,omitempty"`
+	SizeAllocated          uint64        `json:"sizeAllocated,omitempty"`
 	Description            string        `json:"description,omitempty"`
 	Type                   int           `json:"type,omitempty"`
 	Format                 int           `json:"format,omitempty"`
@@ -298,6 +472,14 @@ type FileContent struct {
 	NFSShare               []Share       `json:"nfsShare,omitempty"`
 	CIFSShare              []Pool        `json:"cifsShare,omitempty"`
 	Health                 HealthContent `json:"health,omitempty"`
+	SnapsSizeAllocated     uint64        `json:"snapsSizeAllocated,omitempty"`
+	DataReductionSizeSaved uint64        `json:"dataReductionSizeSaved,omitempty"`
+}
+
+// ListFilesystem Struct to capture the response of Filesystem list
+type ListFilesystem struct {
+	Links       []Link       `json:"links"`
+	Filesystems []Filesystem `json:"entries"`
 }
 
 // Share object to capture NFS Share object from FileContent
@@ -332,9 +514,16 @@ type NASServer struct {
 
 // NASServerContent struct to capture NAS Server object
 type NASServerContent struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name,omitempty"`
-	NFSServer NFSServer `json:"nfsServer,omitempty"`
+	ID        string        `json:"id"`
+	Name      string        `json:"name,omitempty"`
+	NFSServer NFSServer     `json:"nfsServer,omitempty"`
+	Tenant    TenantContent `json:"tenant,omitempty"`
+}
+
+// ListNASServer struct to capture NAS Server list
+type ListNASServer struct {
+	Links   []Link      `json:"links"`
+	Entries []NASServer `json:"entries"`
 }
 
 // NFSServer struct to capture NFS Server object
@@ -369,8 +558,20 @@ type LicenseInfo struct {
 
 // LicenseInfoContent for features on Array
 type LicenseInfoContent struct {
-	IsInstalled bool `json:"isInstalled"`
-	IsValid     bool `json:"isValid"`
+	ID          string      `json:"id,omitempty"`
+	Name        string      `json:"name,omitempty"`
+	Version     string      `json:"version,omitempty"`
+	IsInstalled bool        `json:"isInstalled"`
+	IsValid     bool        `json:"isValid"`
+	IsPermanent bool        `json:"isPermanent"`
+	Issued      time.Time   `json:"issued,omitempty"`
+	Expires     time.Time   `json:"expires,omitempty"`
+	Feature     ResourceRef `json:"feature,omitempty"`
+}
+
+// ListLicenseInfo for the licenses on Array
+type ListLicenseInfo struct {
+	Entries []LicenseInfo `json:"entries"`
 }
 
 // HostInitiatorPath struct to capture host initiator path object
@@ -378,14 +579,32 @@ type HostInitiatorPath struct {
 	HostInitiatorPathContent HostInitiatorPathContent `json:"content"`
 }
 
+// ListHostInitiatorPath struct to capture host initiator path list
+type ListHostInitiatorPath struct {
+	HostInitiatorPaths []HostInitiatorPath `json:"entries"`
+}
+
 // HostInitiatorPathContent struct to capture host initiator parameters
 type HostInitiatorPathContent struct {
-	FcPortID FcPortID `json:"fcPort"`
+	ID           string         `json:"id,omitempty"`
+	IsLoggedIn   bool           `json:"isLoggedIn"`
+	HostPushName string         `json:"hostPushName,omitempty"`
+	SessionIDs   []string       `json:"sessionIds,omitempty"`
+	Initiator    Initiators     `json:"initiator,omitempty"`
+	FcPortID     FcPortID       `json:"fcPort"`
+	IscsiPortal  IscsiPortalRef `json:"iscsiPortal,omitempty"`
 }
 
 // FcPortID struct to capture FC port ID
 type FcPortID struct {
-	ID string `json:"id"`
+	ID  string `json:"id"`
+	Wwn string `json:"wwn,omitempty"`
+}
+
+// IscsiPortalRef struct to capture iSCSI portal referenced by a host initiator path
+type IscsiPortalRef struct {
+	ID        string `json:"id"`
+	IPAddress string `json:"ipAddress,omitempty"`
 }
 
 // FcPort struct to capture FC port object
@@ -393,9 +612,104 @@ type FcPort struct {
 	FcPortContent FcPortContent `json:"content"`
 }
 
-// FcPortContent struct to capture FC port ID
+// FcPortContent struct to capture FC port parameters
 type FcPortContent struct {
-	Wwn string `json:"wwn"`
+	ID               string          `json:"id,omitempty"`
+	Name             string          `json:"name,omitempty"`
+	Wwn              string          `json:"wwn"`
+	SlotNumber       int             `json:"slotNumber,omitempty"`
+	CurrentSpeed     int             `json:"currentSpeed,omitempty"`
+	AvailableSpeeds  []int           `json:"availableSpeeds,omitempty"`
+	StorageProcessor StorageResource `json:"storageProcessor,omitempty"`
+	Health           HealthContent   `json:"health,omitempty"`
+}
+
+// ListFcPort struct to capture FC port list
+type ListFcPort struct {
+	Entries []FcPort `json:"entries"`
+}
+
+// EthernetPort struct to capture ethernet port object
+type EthernetPort struct {
+	EthernetPortContent EthernetPortContent `json:"content"`
+}
+
+// EthernetPortContent struct to capture ethernet port parameters
+type EthernetPortContent struct {
+	ID               string          `json:"id"`
+	Name             string          `json:"name,omitempty"`
+	PortNumber       int             `json:"portNumber,omitempty"`
+	Speed            int             `json:"speed,omitempty"`
+	Mtu              int             `json:"mtu,omitempty"`
+	MacAddress       string          `json:"macAddress,omitempty"`
+	IsLinkUp         bool            `json:"isLinkUp"`
+	StorageProcessor StorageResource `json:"storageProcessor,omitempty"`
+	Health           HealthContent   `json:"health,omitempty"`
+}
+
+// ListEthernetPort struct to capture ethernet port list
+type ListEthernetPort struct {
+	Entries []EthernetPort `json:"entries"`
+}
+
+// IscsiPortal struct to capture iSCSI portal object
+type IscsiPortal struct {
+	IscsiPortalContent IscsiPortalContent `json:"content"`
+}
+
+// IscsiPortalContent struct to capture iSCSI portal parameters
+type IscsiPortalContent struct {
+	ID                string          `json:"id"`
+	IPAddress         string          `json:"ipAddress"`
+	Netmask           string          `json:"netmask,omitempty"`
+	Gateway           string          `json:"gateway,omitempty"`
+	VlanID            int             `json:"vlanId,omitempty"`
+	IPProtocolVersion int             `json:"ipProtocolVersion,omitempty"`
+	EthernetPort      EthernetPortRef `json:"ethernetPort,omitempty"`
+	IscsiNode         IscsiNodeRef    `json:"iscsiNode,omitempty"`
+}
+
+// EthernetPortRef struct to capture ethernet port referenced by an iSCSI portal
+type EthernetPortRef struct {
+	ID               string          `json:"id"`
+	StorageProcessor StorageResource `json:"storageProcessor,omitempty"`
+}
+
+// IscsiNodeRef struct to capture iSCSI node referenced by an iSCSI portal
+type IscsiNodeRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// ListIscsiPortal struct to capture iSCSI portal list
+type ListIscsiPortal struct {
+	Entries []IscsiPortal `json:"entries"`
+}
+
+// TargetInfo aggregates the front-end targets of the array which hosts can log in to
+type TargetInfo struct {
+	ISCSITargets []ISCSITarget
+	// ISCSITargetIQNs holds the distinct iSCSI target IQNs of each SP, keyed by SP ID
+	ISCSITargetIQNs map[string][]string
+	FCTargets       []FCTarget
+}
+
+// ISCSITarget describes an iSCSI target portal
+type ISCSITarget struct {
+	IQN              string
+	Portal           string
+	StorageProcessor string
+	EthernetPort     string
+	VlanID           int
+	LinkUp           bool
+}
+
+// FCTarget describes an FC target port. The REST API reports no link state for FC ports, their health is given instead.
+type FCTarget struct {
+	WWN              string
+	WWPN             string
+	StorageProcessor string
+	Health           HealthContent
 }
 
 // MetricRealTimeQuery is body of a request to create a MetricCollection query
@@ -442,6 +756,68 @@ type MetricQueryResult struct {
 	Entries []MetricResultEntry `json:"entries"`
 }
 
+// MetricValueContent is a historical sample of a metric returned by /api/types/metricValue/instances
+type MetricValueContent struct {
+	Path      string                 `json:"path"`
+	Timestamp string                 `json:"timestamp"`
+	Interval  int                    `json:"interval"`
+	Values    map[string]interface{} `json:"values"`
+}
+
+// MetricValueEntry is part of the response from /api/types/metricValue/instances
+type MetricValueEntry struct {
+	Content MetricValueContent `json:"content"`
+}
+
+// ListMetricValue is a page of the response from /api/types/metricValue/instances
+type ListMetricValue struct {
+	EntryCount int                `json:"entryCount"`
+	Links      []Link             `json:"links"`
+	Entries    []MetricValueEntry `json:"entries"`
+}
+
+// MetricPoint is a single sample of a metric time series
+type MetricPoint struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// MetricSeries is the time series of a metric for a single object. InstanceKey identifies the
+// object, e.g. "spa" for sp.*.cpu.summary.busyTicks or "spa/sv_1" for sp.*.storage.lun.*.readsRate.
+type MetricSeries struct {
+	Path        string
+	InstanceKey string
+	Interval    int
+	Points      []MetricPoint
+}
+
+// MetricSample is a single value of a metric for a single object. InstanceKey identifies the object
+// as in MetricSeries.
+type MetricSample struct {
+	Path        string
+	InstanceKey string
+	Timestamp   time.Time
+	Value       float64
+}
+
+// ResourcePerformance holds the average performance of a LUN or filesystem over a time window
+type ResourcePerformance struct {
+	ResourceID string
+	Start      time.Time
+	End        time.Time
+	// Interval is the sampling interval of the metrics averaged
+	Interval time.Duration
+	// Samples is the number of samples averaged, zero when the array kept no metrics for the window
+	Samples   int
+	ReadIOPS  float64
+	WriteIOPS float64
+	// ReadBandwidth and WriteBandwidth are in bytes per second
+	ReadBandwidth  float64
+	WriteBandwidth float64
+	AverageLatency time.Duration
+	QueueDepth     float64
+}
+
 // MetricContent is part of the response from /api/types/metric/instances
 type MetricContent struct {
 	ID int `json:"id"`
@@ -479,15 +855,80 @@ type MetricInstance struct {
 	Content MetricInfo `json:"content"`
 }
 
+// ListMetricInfo is a page of the response from /api/types/metric/instances
+type ListMetricInfo struct {
+	EntryCount int              `json:"entryCount"`
+	Links      []Link           `json:"links"`
+	Entries    []MetricInstance `json:"entries"`
+}
+
+// CapacityUsage aggregates the capacity of a set of storage resources, in bytes
+type CapacityUsage struct {
+	Count int
+	// Provisioned is the size of the resources as seen by their hosts
+	Provisioned uint64
+	Used        uint64
+	// Allocated is the pool capacity allocated to the resources, after data reduction
+	Allocated          uint64
+	Snapshot           uint64
+	DataReductionSaved uint64
+}
+
+// Add adds the capacity of a storage resource to the usage
+func (u *CapacityUsage) Add(provisioned, used, allocated, snapshot, dataReductionSaved uint64) {
+	u.Count++
+	u.Provisioned += provisioned
+	u.Used += used
+	u.Allocated += allocated
+	u.Snapshot += snapshot
+	u.DataReductionSaved += dataReductionSaved
+}
+
+// DataReductionRatio returns the ratio of the capacity the resources would allocate without data reduction to the
+// capacity they allocate. It is 1 when nothing is allocated.
+func (u CapacityUsage) DataReductionRatio() float64 {
+	if u.Allocated == 0 {
+		return 1
+	}
+	return float64(u.Allocated+u.DataReductionSaved) / float64(u.Allocated)
+}
+
+// PoolCapacity holds the capacity of a storage pool and of the storage resources allocated from it, in bytes
+type PoolCapacity struct {
+	ID                 string
+	Name               string
+	Total              uint64
+	Free               uint64
+	Used               uint64
+	Subscribed         uint64
+	Snapshot           uint64
+	DataReductionSaved uint64
+	DataReductionRatio float64
+	Resources          CapacityUsage
+}
+
+// CapacityReport aggregates the capacity of the array per pool, resource type, tenant and host
+type CapacityReport struct {
+	System      SystemCapacityMetricResult
+	Pools       []PoolCapacity
+	LUNs        CapacityUsage
+	Filesystems CapacityUsage
+	// Tenants is keyed by tenant ID. LUNs count towards the tenants of the hosts they are exposed to and
+	// filesystems towards the tenant of their NAS server.
+	Tenants map[string]CapacityUsage
+	// Hosts is keyed by host ID and counts the LUNs exposed to each host
+	Hosts map[string]CapacityUsage
+}
+
 // SystemCapacityMetricResult is part of response of a SystemCapacityMetricsQueryResult query
 type SystemCapacityMetricResult struct {
 	ID               string `json:"id"`
-	SizeFree         int    `json:"sizeFree"`
-	SizeTotal        int    `json:"sizeTotal"`
-	SizeUsed         int    `json:"sizeUsed"`
-	SizePreallocated int    `json:"sizePreallocated"`
-	SizeSubscribed   int    `json:"sizeSubscribed"`
-	TotalLogicalSize int    `json:"totalLogicalSize"`
+	SizeFree         uint64 `json:"sizeFree"`
+	SizeTotal        uint64 `json:"sizeTotal"`
+	SizeUsed         uint64 `json:"sizeUsed"`
+	SizePreallocated uint64 `json:"sizePreallocated"`
+	SizeSubscribed   uint64 `json:"sizeSubscribed"`
+	TotalLogicalSize uint64 `json:"totalLogicalSize"`
 }
 
 // SystemCapacityMetricsResultEntry is part of response of a SystemCapacityMetricsQueryResult query
@@ -515,3 +956,159 @@ type MaxVolumSizeContent struct {
 type MaxVolumSizeInfo struct {
 	MaxVolumSizeContent MaxVolumSizeContent `json:"content"`
 }
+
+// LoginSession struct to capture the login session object
+type LoginSession struct {
+	LoginSessionContent LoginSessionContent `json:"content"`
+}
+
+// LoginSessionContent struct to capture the login session parameters
+type LoginSessionContent struct {
+	ID string `json:"id"`
+	// IdleTimeout is the number of seconds after which an idle session expires
+	IdleTimeout int `json:"idleTimeout"`
+}
+
+// ListLoginSession struct to capture the login session list
+type ListLoginSession struct {
+	Entries []LoginSession `json:"entries"`
+}
+
+// SystemLimit is a response from querying systemLimit
+type SystemLimit struct {
+	SystemLimitContent SystemLimitContent `json:"content"`
+}
+
+// SystemLimitContent struct to capture the system limit parameters
+type SystemLimitContent struct {
+	ID             string `json:"id"`
+	Name           string `json:"name,omitempty"`
+	Description    string `json:"description,omitempty"`
+	Unit           int    `json:"unit"`
+	LimitValue     uint64 `json:"limitValue"`
+	ThresholdValue uint64 `json:"thresholdValue,omitempty"`
+	// UnitName is the decoded unit of the limit
+	UnitName string `json:"-"`
+	// Usage is the current number of objects counted against the limit, set by GetSystemLimit only when the client can count them
+	Usage *uint64 `json:"-"`
+}
+
+// ListSystemLimit struct to capture the system limit list
+type ListSystemLimit struct {
+	Entries []SystemLimit `json:"entries"`
+}
+
+// EntryCount struct to capture the number of instances of a resource type
+type EntryCount struct {
+	EntryCount uint64 `json:"entryCount"`
+}
+
+// Alert struct to capture the alert object
+type Alert struct {
+	AlertContent AlertContent `json:"content"`
+}
+
+// AlertContent struct to capture the alert parameters
+type AlertContent struct {
+	ID             string      `json:"id"`
+	Timestamp      time.Time   `json:"timestamp"`
+	Severity       int         `json:"severity"`
+	State          int         `json:"state"`
+	Component      ResourceRef `json:"component,omitempty"`
+	MessageID      string      `json:"messageId,omitempty"`
+	Message        string      `json:"message,omitempty"`
+	Description    string      `json:"description,omitempty"`
+	Resolution     string      `json:"resolution,omitempty"`
+	IsAcknowledged bool        `json:"isAcknowledged"`
+}
+
+// ResourceRef struct to capture a reference to an object of any resource type
+type ResourceRef struct {
+	ID       string `json:"id"`
+	Resource string `json:"resource,omitempty"`
+}
+
+// ListAlert struct to capture a page of the alert list
+type ListAlert struct {
+	Links   []Link  `json:"links"`
+	Entries []Alert `json:"entries"`
+}
+
+// Event struct to capture the event object
+type Event struct {
+	EventContent EventContent `json:"content"`
+}
+
+// EventContent struct to capture the event parameters
+type EventContent struct {
+	ID           string    `json:"id"`
+	Node         int       `json:"node"`
+	CreationTime time.Time `json:"creationTime"`
+	Severity     int       `json:"severity"`
+	MessageID    string    `json:"messageId,omitempty"`
+	Message      string    `json:"message,omitempty"`
+	Username     string    `json:"username,omitempty"`
+	Category     int       `json:"category"`
+	Source       string    `json:"source,omitempty"`
+}
+
+// ListEvent struct to capture a page of the event list
+type ListEvent struct {
+	Links   []Link  `json:"links"`
+	Entries []Event `json:"entries"`
+}
+
+// HardwareComponent struct to capture a hardware component of the array
+type HardwareComponent struct {
+	HardwareComponentContent HardwareComponentContent `json:"content"`
+}
+
+// HardwareComponentContent struct to capture the hardware component parameters. Not every resource type reports every parameter.
+type HardwareComponentContent struct {
+	// Type is the resource type of the component, such as disk or fan
+	Type                 string        `json:"-"`
+	ID                   string        `json:"id"`
+	Name                 string        `json:"name,omitempty"`
+	Health               HealthContent `json:"health,omitempty"`
+	SlotNumber           int           `json:"slotNumber"`
+	Model                string        `json:"model,omitempty"`
+	EmcSerialNumber      string        `json:"emcSerialNumber,omitempty"`
+	EmcPartNumber        string        `json:"emcPartNumber,omitempty"`
+	Manufacturer         string        `json:"manufacturer,omitempty"`
+	FirmwareVersion      string        `json:"firmwareVersion,omitempty"`
+	Version              string        `json:"version,omitempty"`
+	BiosFirmwareRevision string        `json:"biosFirmwareRevision,omitempty"`
+	Size                 uint64        `json:"size,omitempty"`
+	MemorySize           uint64        `json:"memorySize,omitempty"`
+}
+
+// Firmware returns the firmware revision of the component, whichever attribute its resource type reports it in
+func (h HardwareComponentContent) Firmware() string {
+	switch {
+	case h.FirmwareVersion != "":
+		return h.FirmwareVersion
+	case h.Version != "":
+		return h.Version
+	default:
+		return h.BiosFirmwareRevision
+	}
+}
+
+// ListHardwareComponent struct to capture a page of a hardware component list
+type ListHardwareComponent struct {
+	Links   []Link              `json:"links"`
+	Entries []HardwareComponent `json:"entries"`
+}
+
+// SystemHealthSummary struct to capture the health of the hardware components of the array
+type SystemHealthSummary struct {
+	// ComponentCounts is the number of components of each resource type
+	ComponentCounts map[string]int
+	// Degraded lists the components whose health is not OK
+	Degraded []HardwareComponentContent
+}
+
+// Healthy returns true when no component is degraded
+func (s SystemHealthSummary) Healthy() bool {
+	return len(s.Degraded) == 0
+}
@@ -18,12 +18,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/dell/gounity/util"
 
@@ -37,19 +41,22 @@ const (
 	emcCsrfToken = "EMC-CSRF-TOKEN" // #nosec G101
 )
 
-var (
-	accHeader string
-	conHeader string
-
-	debug, _    = strconv.ParseBool(os.Getenv("GOUNITY_DEBUG"))
-	showHTTP, _ = strconv.ParseBool(os.Getenv("GOUNITY_SHOWHTTP"))
-	errNoLink   = errors.New("error: problem finding link")
-)
+var errNoLink = errors.New("error: problem finding link")
 
 // UnityClient interface for Unity Client
 type UnityClient interface {
 	Authenticate(ctx context.Context, configConnect *ConfigConnect) error
-	BasicSystemInfo(ctx context.Context, configConnect *ConfigConnect) error
+	BasicSystemInfo(ctx context.Context, configConnect *ConfigConnect) (*types.BasicSystemInfo, error)
+	GetSystemInfo(ctx context.Context) (*types.SystemInfo, error)
+	Capabilities() *Capabilities
+	StartKeepalive(ctx context.Context, opts KeepaliveOptions) error
+	StopKeepalive()
+	SessionStats() SessionStats
+	Logout(ctx context.Context) error
+	Close() error
+	ListLicenses(ctx context.Context) ([]types.LicenseInfo, error)
+	GetLicense(ctx context.Context, licenseName LicenseType) (*types.LicenseInfo, error)
+	ListFeatures(ctx context.Context) ([]types.Feature, error)
 	GetToken() string
 	SetToken(token string)
 	CreateFilesystem(ctx context.Context, name string, storagepool string, description string, nasServer string, size uint64, tieringPolicy int, hostIOSize int, supportedProtocol int, isThinEnabled bool, isDataReductionEnabled bool) (*types.Filesystem, error)
@@ -60,6 +67,7 @@ type UnityClient interface {
 	DeleteNFSShareCreatedFromSnapshot(ctx context.Context, nfsShareID string) error
 	ExpandFilesystem(ctx context.Context, filesystemID string, newSize uint64) error
 	FindFilesystemByID(ctx context.Context, filesystemID string) (*types.Filesystem, error)
+	ListFilesystems(ctx context.Context) ([]types.Filesystem, error)
 	FindFilesystemByName(ctx context.Context, filesystemName string) (*types.Filesystem, error)
 	FindNASServerByID(ctx context.Context, nasServerID string) (*types.NASServer, error)
 	FindNFSShareByID(ctx context.Context, nfsShareID string) (*types.NFSShare, error)
@@ -69,7 +77,12 @@ type UnityClient interface {
 	ModifyNFSShareHostAccess(ctx context.Context, filesystemID string, nfsShareID string, hostIDs []string, accessType AccessType) error
 	FindHostByName(ctx context.Context, hostName string) (*types.Host, error)
 	CreateHost(ctx context.Context, hostName string, tenantID string) (*types.Host, error)
+	CreateHostWithOptions(ctx context.Context, opts *CreateHostOptions) (*types.Host, error)
+	ModifyHost(ctx context.Context, hostID string, opts *ModifyHostOptions) error
+	ListHosts(ctx context.Context, filter *HostFilter) ([]types.Host, error)
+	ListHostLUNs(ctx context.Context, hostID string) ([]types.HostLUN, error)
 	DeleteHost(ctx context.Context, hostName string) error
+	DeleteHostByID(ctx context.Context, hostID string) error
 	CreateHostIPPort(ctx context.Context, hostID, ip string) (*types.HostIPPort, error)
 	FindHostIPPortByID(ctx context.Context, hostIPID string) (*types.HostIPPort, error)
 	ListHostInitiators(ctx context.Context) ([]types.HostInitiator, error)
@@ -78,15 +91,37 @@ type UnityClient interface {
 	CreateHostInitiator(ctx context.Context, hostID, wwnOrIqn string, initiatorType types.InitiatorType) (*types.HostInitiator, error)
 	ModifyHostInitiator(ctx context.Context, hostID string, initiator *types.HostInitiator) (*types.HostInitiator, error)
 	ModifyHostInitiatorByID(ctx context.Context, hostID, initiatorID string) (*types.HostInitiator, error)
+	DeleteHostInitiator(ctx context.Context, initiatorID string) error
+	SetInitiatorIgnored(ctx context.Context, initiatorID string, ignored bool) error
+	SetInitiatorCHAP(ctx context.Context, initiatorID string, chap *InitiatorCHAP) error
+	SetMutualCHAP(ctx context.Context, username, secret string) error
+	ListInitiatorPaths(ctx context.Context, initiatorID string) ([]types.HostInitiatorPath, error)
 	FindHostInitiatorPathByID(ctx context.Context, initiatorPathID string) (*types.HostInitiatorPath, error)
 	FindFcPortByID(ctx context.Context, fcPortID string) (*types.FcPort, error)
 	FindTenants(ctx context.Context) (*types.TenantInfo, error)
 	ListIscsiIPInterfaces(ctx context.Context) ([]types.IPInterfaceEntries, error)
+	ListISCSIPortals(ctx context.Context) ([]types.IscsiPortal, error)
+	ListFCPorts(ctx context.Context) ([]types.FcPort, error)
+	ListEthernetPorts(ctx context.Context) ([]types.EthernetPort, error)
+	GetTargetInfo(ctx context.Context) (*types.TargetInfo, error)
 	CreateRealTimeMetricsQuery(ctx context.Context, metricPaths []string, interval int) (*types.MetricQueryCreateResponse, error)
 	DeleteRealTimeMetricsQuery(ctx context.Context, queryID int) error
 	GetAllRealTimeMetricPaths(ctx context.Context) error
+	ListMetrics(ctx context.Context, filter *MetricFilter) ([]types.MetricInfo, error)
 	GetCapacity(ctx context.Context) (*types.SystemCapacityMetricsQueryResult, error)
+	GetCapacityReport(ctx context.Context) (*types.CapacityReport, error)
 	GetMetricsCollection(ctx context.Context, queryID int) (*types.MetricQueryResult, error)
+	GetHistoricalMetrics(ctx context.Context, query *HistoricalMetricsQuery) ([]types.MetricSeries, error)
+	GetLunPerformance(ctx context.Context, lunID string, window time.Duration) (*types.ResourcePerformance, error)
+	GetFilesystemPerformance(ctx context.Context, filesystemID string, window time.Duration) (*types.ResourcePerformance, error)
+	NewMetricsStream(ctx context.Context, metricPaths []string, interval int) (*MetricsStream, error)
+	ListAlerts(ctx context.Context, filter *AlertFilter) ([]types.Alert, error)
+	AcknowledgeAlert(ctx context.Context, alertID string) error
+	DeleteAlert(ctx context.Context, alertID string) error
+	ListEvents(ctx context.Context, filter *EventFilter) ([]types.Event, error)
+	WatchAlerts(ctx context.Context, since time.Time) <-chan types.Alert
+	ListHardwareComponents(ctx context.Context, componentType HardwareComponentType) ([]types.HardwareComponent, error)
+	SystemHealthSummary(ctx context.Context) (*types.SystemHealthSummary, error)
 	CopySnapshot(ctx context.Context, sourceSnapshotID string, name string) (*types.Snapshot, error)
 	CreateSnapshot(ctx context.Context, storageResourceID string, snapshotName string, description string, retentionDuration string) (*types.Snapshot, error)
 	CreateSnapshotWithFsAccesType(ctx context.Context, storageResourceID string, snapshotName string, _ string, retentionDuration string, filesystemAccessType FilesystemAccessType) (*types.Snapshot, error)
@@ -99,6 +134,10 @@ type UnityClient interface {
 	ModifySnapshotAutoDeleteParameter(ctx context.Context, snapshotID string) error
 	FindStoragePoolByName(ctx context.Context, poolName string) (*types.StoragePool, error)
 	FindStoragePoolByID(ctx context.Context, poolID string) (*types.StoragePool, error)
+	ListStoragePools(ctx context.Context) ([]types.StoragePool, error)
+	CreateStoragePool(ctx context.Context, opts *CreateStoragePoolOptions) (*types.StoragePool, error)
+	ExpandStoragePool(ctx context.Context, poolID string, raidGroups []RaidGroupSpec) error
+	ModifyStoragePool(ctx context.Context, poolID string, opts *ModifyStoragePoolOptions) error
 	CreateCloneFromVolume(ctx context.Context, name string, volID string) (*types.Volume, error)
 	CreateLun(ctx context.Context, name string, poolID string, description string, size uint64, fastVPTieringPolicy int, hostIOLimitID string, isThinEnabled bool, isDataReductionEnabled bool) (*types.Volume, error)
 	CreteLunThinClone(ctx context.Context, name string, snapID string, volID string) (*types.Volume, error)
@@ -109,6 +148,8 @@ type UnityClient interface {
 	FindVolumeByID(ctx context.Context, volID string) (*types.Volume, error)
 	FindVolumeByName(ctx context.Context, volName string) (*types.Volume, error)
 	GetMaxVolumeSize(ctx context.Context, systemLimitID string) (*types.MaxVolumSizeInfo, error)
+	ListSystemLimits(ctx context.Context) ([]types.SystemLimit, error)
+	GetSystemLimit(ctx context.Context, limitID SystemLimitID) (*types.SystemLimit, error)
 	ListVolumes(ctx context.Context, startToken int, maxEntries int) ([]types.Volume, int, error)
 	ModifyVolumeExport(ctx context.Context, volID string, hostIDList []string) error
 	RenameVolume(ctx context.Context, newName string, volID string) error
@@ -117,66 +158,103 @@ type UnityClient interface {
 
 // UnityClientImpl Struct holds the configuration & REST Client.
 type UnityClientImpl struct {
-	configConnect *ConfigConnect
-	api           api.Client
-	loginMutex    sync.Mutex
+	configConnect  *ConfigConnect
+	api            api.Client
+	loginMutex     sync.Mutex
+	initiatorIndex initiatorIndex
+	metricCatalog  metricCatalog
+	systemInfo     systemInfoCache
+	capabilities   atomic.Pointer[Capabilities]
+	licenseCache   licenseCache
+	session        session
+	logger         util.Logger
+	// arrayID identifies the array in the log messages, the endpoint host until the serial number is known
+	arrayID atomic.Pointer[string]
 }
 
 // ConfigConnect Struct holds the endpoint & credential info.
+// When Credentials is set, it is used instead of Username and Password.
 type ConfigConnect struct {
-	Endpoint string
-	Username string
-	Password string
-	Insecure bool
+	Endpoint    string
+	Username    string
+	Password    string
+	Credentials CredentialProvider
+	Insecure    bool
 }
 
 // BasicSystemInfo make a REST API call [/basicSystemInfo/instances] to Unity to check if array is responding.
-func (c *UnityClientImpl) BasicSystemInfo(ctx context.Context, configConnect *ConfigConnect) error {
-	log := util.GetRunIDLogger(ctx)
-	log.Debug("Executing BasicSystemInfo REST client")
+// The decoded response is returned and cached for GetSystemInfo.
+func (c *UnityClientImpl) BasicSystemInfo(ctx context.Context, configConnect *ConfigConnect) (*types.BasicSystemInfo, error) {
+	c.loginMutex.Lock()
 	c.configConnect = configConnect
+	c.loginMutex.Unlock()
+	return c.basicSystemInfo(ctx)
+}
+
+// basicSystemInfo gets the basicSystemInfo instances without changing the client configuration, it needs no login
+func (c *UnityClientImpl) basicSystemInfo(ctx context.Context) (*types.BasicSystemInfo, error) {
+	log := c.log(ctx)
+	log.Debug("Executing BasicSystemInfo REST client")
 	headers := make(map[string]string, 3)
 	headers[api.XEmcRestClient] = "true"
 	headers[api.HeaderKeyContentType] = api.HeaderValContentTypeJSON
 	resp, err := c.api.DoAndGetResponseBody(ctx, http.MethodGet, api.UnityAPIBasicSysInfoURI, headers, nil)
 	if err != nil {
-		return fmt.Errorf("Error getting BasicSystemInfo: %v", err)
+		return nil, fmt.Errorf("Error getting BasicSystemInfo: %v", err)
 	}
 
-	if resp != nil {
-		log.Debugf("BasicSystemInfo response code: %d", resp.StatusCode)
-		if err != nil {
-			log.Errorf("Reading BasicSystemInfo response body error:%v", err)
-		}
-
-		defer resp.Body.Close()
-
-		switch {
-		case resp.StatusCode >= 200 && resp.StatusCode <= 299:
-			{
-				log.Debug("Getting BasicSystemInfo details successful")
-			}
-		default:
-			return fmt.Errorf("Get BaicSystemInfo error. Response: %v", c.api.ParseJSONError(ctx, resp))
-		}
+	if resp == nil {
+		return nil, errors.New("Getting BasicSystemInfo details failed: nil response received")
+	}
+	log.Debugf("BasicSystemInfo response code: %d", resp.StatusCode)
+	defer resp.Body.Close()
 
-	} else {
-		log.Errorf("Getting BasicSystenInfo details faile")
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("Get BasicSystemInfo error. Response: %v", c.api.ParseJSONError(ctx, resp))
 	}
-	return nil
+	basicSystemInfo := &types.BasicSystemInfo{}
+	if err := json.NewDecoder(resp.Body).Decode(basicSystemInfo); err != nil {
+		return nil, fmt.Errorf("Reading BasicSystemInfo response body error: %v", err)
+	}
+	if len(basicSystemInfo.Entries) == 0 {
+		return nil, errors.New("BasicSystemInfo response has no entries")
+	}
+	log.Debug("Getting BasicSystemInfo details successful")
+
+	c.systemInfo.Lock()
+	c.systemInfo.basic = &basicSystemInfo.Entries[0].Content
+	c.systemInfo.Unlock()
+	return basicSystemInfo, nil
 }
 
 // Authenticate make a REST API call [/loginSessionInfo] to Unity to get authenticate the given credentials.
 // The response contains the EMC-CSRF-TOKEN and the client caches it for further communication.
+// The credentials are fetched from the credential provider at every call. A nil configConnect reuses the
+// configuration of the previous call, or the one of the client created by NewClient.
 func (c *UnityClientImpl) Authenticate(ctx context.Context, configConnect *ConfigConnect) error {
 	c.loginMutex.Lock()
 	defer c.loginMutex.Unlock()
-	log := util.GetRunIDLogger(ctx)
+	return c.authenticateLocked(ctx, configConnect)
+}
+
+// authenticateLocked logs in to the array, the caller holds the login lock
+func (c *UnityClientImpl) authenticateLocked(ctx context.Context, configConnect *ConfigConnect) error {
+	log := c.log(ctx)
 	log.Debug("Executing Authenticate REST client")
+	if configConnect == nil {
+		configConnect = c.configConnect
+	}
+	if configConnect == nil {
+		return errors.New("authentication error: no connection configuration")
+	}
 	c.configConnect = configConnect
+	credentials, err := configConnect.credentials(ctx)
+	if err != nil {
+		return fmt.Errorf("authentication error: %v", err)
+	}
 	c.api.SetToken("")
 	headers := make(map[string]string, 3)
-	headers[api.AuthorizationHeader] = "Basic " + basicAuth(configConnect.Username, configConnect.Password)
+	headers[api.AuthorizationHeader] = "Basic " + basicAuth(credentials.Username, credentials.Password)
 	headers[api.XEmcRestClient] = "true"
 	headers[api.HeaderKeyContentType] = api.HeaderValContentTypeJSON
 	resp, err := c.api.DoAndGetResponseBody(ctx, http.MethodGet, api.UnityAPILoginSessionInfoURI, headers, nil)
@@ -206,6 +284,11 @@ func (c *UnityClientImpl) Authenticate(ctx context.Context, configConnect *Confi
 		}
 
 		c.api.SetToken(resp.Header.Get(emcCsrfToken))
+		c.sessionStarted(ctx)
+		// Detected at every login, as the array may have been upgraded since the previous one
+		if err := c.detectCapabilities(ctx); err != nil {
+			log.Warnf("Unable to detect array capabilities: %v", err)
+		}
 	} else {
 		log.Errorf("Authenticate error: Nil response received")
 	}
@@ -221,11 +304,9 @@ func basicAuth(username, password string) string {
 // GetJSONWithRetry method responsible to make the given API call to Unity REST API Server.
 // In case if the given EMC-CSRF-TOKEN becomes invalid, retries the same operation after performing authentication.
 func (c *UnityClientImpl) executeWithRetryAuthenticate(ctx context.Context, method, uri string, body, resp interface{}) error {
-	log := util.GetRunIDLogger(ctx)
-	headers := make(map[string]string, 2)
-	headers[api.HeaderKeyAccept] = accHeader
-	headers[api.HeaderKeyContentType] = conHeader
-	headers[api.XEmcRestClient] = "true"
+	log := c.log(ctx).WithFields(map[string]interface{}{util.FieldMethod: method, util.FieldURI: uri})
+	headers := c.requestHeaders()
+	logins := c.loginCount()
 	log.Debug("Invoking REST API server info Method: ", method, ", URI: ", uri)
 	err := c.api.DoWithHeaders(ctx, method, uri, headers, body, resp)
 	if err == nil {
@@ -234,11 +315,12 @@ func (c *UnityClientImpl) executeWithRetryAuthenticate(ctx context.Context, meth
 	}
 	// check if we need to authenticate
 	if e, ok := err.(*types.Error); ok {
+		log = log.WithFields(map[string]interface{}{util.FieldErrorCode: e.ErrorContent.ErrorCode})
 		log.Debugf("Error in response. Method:%s URI:%s Error: %v JSON Error: %+v", method, uri, err, e)
 		if e.ErrorContent.HTTPStatusCode == 401 {
 			log.Debug("need to re-authenticate")
-			// Authenticate then try again
-			if err := c.Authenticate(ctx, c.configConnect); err != nil {
+			// Authenticate then try again, once per expired session however many requests fail with it
+			if err := c.reauthenticateAfter(ctx, logins); err != nil {
 				return fmt.Errorf("authentication failure due to: %v", err)
 			}
 			log.Debug("Authentication success")
@@ -252,6 +334,11 @@ func (c *UnityClientImpl) executeWithRetryAuthenticate(ctx context.Context, meth
 	return err
 }
 
+// requestHeaders returns the headers sent with every REST API request, the API client adds its default headers
+func (c *UnityClientImpl) requestHeaders() map[string]string {
+	return map[string]string{api.XEmcRestClient: "true"}
+}
+
 // SetToken function sets token
 func (c *UnityClientImpl) SetToken(token string) {
 	c.api.SetToken(token)
@@ -263,23 +350,48 @@ func (c *UnityClientImpl) GetToken() string {
 }
 
 // NewClient initialize the new REST Client with default options.
+// The endpoint, the certificate verification, the debug logs and the HTTP dumps are configured by the
+// GOUNITY_ENDPOINT, GOUNITY_INSECURE, GOUNITY_DEBUG and GOUNITY_SHOWHTTP environment variables.
+// The credentials are read from GOUNITY_USERNAME and GOUNITY_PASSWORD when Authenticate is called with a nil configuration.
 func NewClient(ctx context.Context) (UnityClient, error) {
 	insecure, _ := strconv.ParseBool(os.Getenv("GOUNITY_INSECURE"))
-	return NewClientWithArgs(ctx, os.Getenv("GOUNITY_ENDPOINT"), insecure)
+	debug, _ := strconv.ParseBool(os.Getenv("GOUNITY_DEBUG"))
+	showHTTP, _ := strconv.ParseBool(os.Getenv("GOUNITY_SHOWHTTP"))
+	client, err := NewClientWithOptions(ctx, os.Getenv("GOUNITY_ENDPOINT"), api.ClientOptions{
+		Insecure: insecure,
+		Debug:    debug,
+		ShowHTTP: showHTTP,
+	})
+	if err != nil {
+		return nil, err
+	}
+	client.(*UnityClientImpl).configConnect = &ConfigConnect{
+		Endpoint:    os.Getenv("GOUNITY_ENDPOINT"),
+		Credentials: &EnvCredentialProvider{},
+		Insecure:    insecure,
+	}
+	return client, nil
 }
 
 // NewClientWithArgs initialize the new REST Client with the given arguments.
 func NewClientWithArgs(ctx context.Context, endpoint string, insecure bool) (UnityClient, error) {
-	log := util.GetRunIDLogger(ctx)
-	if util.ShowHTTP {
-		util.Debug = true
+	return NewClientWithOptions(ctx, endpoint, api.ClientOptions{Insecure: insecure})
+}
+
+// NewClientWithOptions initialize the new REST Client with the given HTTP client options,
+// e.g. a CA bundle, a client certificate or pinned certificate fingerprints.
+// The options only apply to the returned client, so differently configured clients can be used concurrently.
+func NewClientWithOptions(ctx context.Context, endpoint string, opts api.ClientOptions) (UnityClient, error) {
+	log := util.ContextLogger(ctx, opts.Logger)
+	if opts.ShowHTTP {
+		opts.Debug = true
 	}
 
 	fields := map[string]interface{}{
 		"endpoint": endpoint,
-		"insecure": insecure,
-		"debug":    util.Debug,
-		"showHTTP": util.ShowHTTP,
+		"insecure": opts.Insecure,
+		"debug":    opts.Debug,
+		"showHTTP": opts.ShowHTTP,
 	}
 
 	log.WithFields(fields).Debug("unity client init")
@@ -289,12 +401,7 @@ func NewClientWithArgs(ctx context.Context, endpoint string, insecure bool) (Uni
 		return nil, withFields(fields, "endpoint is required")
 	}
 
-	opts := api.ClientOptions{
-		Insecure: insecure,
-		ShowHTTP: util.ShowHTTP,
-	}
-
-	ac, err := api.New(ctx, endpoint, opts, util.Debug)
+	ac, err := api.New(ctx, endpoint, opts, opts.Debug)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create HTTP client %v", err)
 	}
@@ -302,8 +409,11 @@ func NewClientWithArgs(ctx context.Context, endpoint string, insecure bool) (Uni
 	client := &UnityClientImpl{
 		api:           ac,
 		configConnect: &ConfigConnect{},
+		logger:        opts.Logger,
+	}
+	if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+		client.arrayID.Store(&u.Host)
 	}
-	conHeader = api.HeaderValContentTypeJSON
 	return client, nil
 }
 
@@ -336,6 +446,15 @@ func withFieldsE(fields map[string]interface{}, message string, inner error) err
 	return fmt.Errorf("%s %s", message, b.String())
 }
 
+// log returns the logger of the context, or else the logger of the client, with the array ID
+func (c *UnityClientImpl) log(ctx context.Context) util.Logger {
+	log := util.ContextLogger(ctx, c.logger)
+	if arrayID := c.arrayID.Load(); arrayID != nil {
+		log = log.WithFields(map[string]interface{}{util.FieldArrayID: *arrayID})
+	}
+	return log
+}
+
 func (c *UnityClientImpl) getAPI() api.Client {
 	return c.api
 }
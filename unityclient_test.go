@@ -15,15 +15,21 @@
 package gounity
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 
+	"github.com/dell/gounity/api"
 	"github.com/dell/gounity/types"
+	"github.com/dell/gounity/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -32,11 +38,25 @@ import (
 type mocksapiClient struct {
 	BaseURL string
 	Token   string
+	// SysInfoStatus and SysInfoBody replace the basicSystemInfo response when set
+	SysInfoStatus int
+	SysInfoBody   string
 }
 
-func (m *mocksapiClient) DoAndGetResponseBody(_ context.Context, _, _ string, _ map[string]string, _ interface{}) (*http.Response, error) {
+func (m *mocksapiClient) DoAndGetResponseBody(_ context.Context, _, uri string, _ map[string]string, _ interface{}) (*http.Response, error) {
 	w := httptest.NewRecorder()
-	w.WriteHeader(http.StatusOK)
+	if m.SysInfoStatus != 0 {
+		w.WriteHeader(m.SysInfoStatus)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	if uri == api.UnityAPIBasicSysInfoURI {
+		if m.SysInfoBody != "" {
+			_, _ = w.WriteString(m.SysInfoBody)
+		} else {
+			_, _ = w.WriteString(`{"entries":[{"content":{"id":"0","model":"Unity 480F","name":"array-1","softwareVersion":"5.4.0","apiVersion":"5.4","earliestApiVersion":"4.0"}}]}`)
+		}
+	}
 	return w.Result(), nil
 }
 
@@ -90,47 +110,55 @@ func TestBasicSystemInfo(t *testing.T) {
 	tests := []struct {
 		name          string
 		statusCode    int
-		expectedError bool
+		body          string
+		expectedError string
 	}{
 		{
-			name:          "Successful response",
-			statusCode:    http.StatusOK,
-			expectedError: false,
+			name:       "Successful response",
+			statusCode: http.StatusOK,
 		},
 		{
 			name:          "Client error response",
 			statusCode:    http.StatusBadRequest,
-			expectedError: true,
+			expectedError: "Get BasicSystemInfo error. Response: mock parse JSON error",
 		},
 		{
 			name:          "Server error response",
 			statusCode:    http.StatusInternalServerError,
-			expectedError: true,
+			expectedError: "Get BasicSystemInfo error. Response: mock parse JSON error",
+		},
+		{
+			name:          "Undecodable response",
+			statusCode:    http.StatusOK,
+			body:          "not json",
+			expectedError: "Reading BasicSystemInfo response body error",
+		},
+		{
+			name:          "Response without entries",
+			statusCode:    http.StatusOK,
+			body:          `{"entries":[]}`,
+			expectedError: "BasicSystemInfo response has no entries",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Create a new HTTP test server
-			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-				w.WriteHeader(tt.statusCode)
-			}))
-			defer server.Close()
-
-			// Create a new client with the test server URL
 			client := &UnityClientImpl{
 				api: &mocksapiClient{
-					BaseURL: server.URL,
+					SysInfoStatus: tt.statusCode,
+					SysInfoBody:   tt.body,
 				},
 			}
 
-			// Call the BasicSystemInfo function
-			err := client.BasicSystemInfo(context.Background(), &ConfigConnect{})
-
-			// Check if an error was expected
-			if tt.expectedError {
-				require.NoError(t, err)
+			info, err := client.BasicSystemInfo(context.Background(), &ConfigConnect{})
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, info)
+				return
 			}
+			require.NoError(t, err)
+			assert.Equal(t, "5.4.0", info.Entries[0].Content.SoftwareVersion)
 		})
 	}
 }
@@ -326,6 +354,54 @@ func TestClientCreation(t *testing.T) {
 	}
 }
 
+func TestNewClientWithOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(api.HeaderKeyContentType, api.HeaderValContentTypeJSON)
+		fmt.Fprintf(w, `{"agent": %q, "accept": %q}`, r.Header.Get("User-Agent"), r.Header.Get(api.HeaderKeyAccept))
+	}))
+	defer server.Close()
+
+	// Differently configured clients do not share their settings
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			agent := fmt.Sprintf("client-%d", i)
+			client, err := NewClientWithOptions(context.Background(), server.URL, api.ClientOptions{
+				ShowHTTP: i%2 == 0,
+				Headers:  map[string]string{"User-Agent": agent},
+			})
+			require.NoError(t, err)
+			resp := &struct {
+				Agent  string `json:"agent"`
+				Accept string `json:"accept"`
+			}{}
+			assert.NoError(t, client.(*UnityClientImpl).executeWithRetryAuthenticate(context.Background(), http.MethodGet, "/api/types/system/instances", nil, resp))
+			assert.Equal(t, agent, resp.Agent)
+			assert.Equal(t, api.HeaderValContentTypeJSON, resp.Accept)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestClientLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error": {"errorCode": 131149829, "httpStatusCode": 404}}`))
+	}))
+	defer server.Close()
+
+	buf := &bytes.Buffer{}
+	logger := util.NewSlogLogger(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client, err := NewClientWithOptions(context.Background(), server.URL, api.ClientOptions{Logger: logger})
+	require.NoError(t, err)
+	_, err = client.FindVolumeByID(context.Background(), "sv_1")
+	assert.Error(t, err)
+	assert.Contains(t, buf.String(), fmt.Sprintf(`"arrayID":%q`, strings.TrimPrefix(server.URL, "http://")))
+	assert.Contains(t, buf.String(), `"errorCode":131149829`)
+}
+
 // SetToken sets the token in the mock API client.
 func (m *mocksapiClient) SetToken(token string) {
 	m.Token = token
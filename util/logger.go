@@ -0,0 +1,178 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Structured log fields set by gounity
+const (
+	FieldArrayID    = "arrayID"
+	FieldRequestID  = "requestID"
+	FieldMethod     = "method"
+	FieldURI        = "uri"
+	FieldStatusCode = "statusCode"
+	FieldErrorCode  = "errorCode"
+)
+
+// Logger is the logger used by gounity. NewLogrusLogger and NewSlogLogger adapt logrus and log/slog loggers.
+type Logger interface {
+	Debug(args ...interface{})
+	Debugf(format string, args ...interface{})
+	Info(args ...interface{})
+	Infof(format string, args ...interface{})
+	Warn(args ...interface{})
+	Warnf(format string, args ...interface{})
+	Error(args ...interface{})
+	Errorf(format string, args ...interface{})
+	// WithFields returns a logger adding the given structured fields to every message
+	WithFields(fields map[string]interface{}) Logger
+	// WithError returns a logger adding the error to every message
+	WithError(err error) Logger
+}
+
+const (
+	loggerKey    contextKey = "gounitylogger"
+	requestIDKey contextKey = "gounityrequestid"
+)
+
+// WithLogger returns a context whose requests are logged by the given logger, instead of the logger of the client
+func WithLogger(ctx context.Context, logger Logger) context.Context {
+	return context.WithValue(ctx, loggerKey, logger)
+}
+
+// WithRequestID returns a context whose log messages carry the given request ID
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// ContextLogger returns the logger of the context set by WithLogger, or else the given logger, or else the
+// *logrus.Entry of the context under UnityLog, or else the shared logrus logger. The fields of the UnityLog entry
+// and the request ID of the context are added to the messages of the given logger.
+func ContextLogger(ctx context.Context, logger Logger) Logger {
+	entry, _ := ctx.Value(UnityLog).(*logrus.Entry)
+	if ctxLogger, ok := ctx.Value(loggerKey).(Logger); ok && ctxLogger != nil {
+		logger = ctxLogger
+	} else if logger != nil {
+		if entry != nil && len(entry.Data) > 0 {
+			logger = logger.WithFields(entry.Data)
+		}
+	} else if entry != nil && len(entry.Data) > 0 {
+		logger = NewLogrusLogger(entry)
+	}
+	if logger == nil {
+		logger = NewLogrusLogger(GetLogger().WithContext(ctx))
+	}
+	if l, ok := logger.(*slogLogger); ok {
+		logger = &slogLogger{logger: l.logger, ctx: ctx}
+	}
+	if requestID, ok := ctx.Value(requestIDKey).(string); ok && requestID != "" {
+		logger = logger.WithFields(map[string]interface{}{FieldRequestID: requestID})
+	}
+	return logger
+}
+
+// logrusLogger adapts a logrus entry to Logger
+type logrusLogger struct {
+	*logrus.Entry
+}
+
+// NewLogrusLogger returns a Logger writing to the given logrus entry
+func NewLogrusLogger(entry *logrus.Entry) Logger {
+	return &logrusLogger{Entry: entry}
+}
+
+func (l *logrusLogger) WithFields(fields map[string]interface{}) Logger {
+	return &logrusLogger{Entry: l.Entry.WithFields(fields)}
+}
+
+func (l *logrusLogger) WithError(err error) Logger {
+	return &logrusLogger{Entry: l.Entry.WithError(err)}
+}
+
+// slogLogger adapts a log/slog logger to Logger. The messages are logged with the context the logger was
+// returned for by ContextLogger, so that the handler can read its values.
+type slogLogger struct {
+	logger *slog.Logger
+	ctx    context.Context
+}
+
+// NewSlogLogger returns a Logger writing to the given log/slog handler
+func NewSlogLogger(handler slog.Handler) Logger {
+	return &slogLogger{logger: slog.New(handler), ctx: context.Background()}
+}
+
+// log formats and logs the message only when the handler handles the level
+func (l *slogLogger) log(level slog.Level, format func() string) {
+	if !l.logger.Enabled(l.ctx, level) {
+		return
+	}
+	l.logger.Log(l.ctx, level, format())
+}
+
+func (l *slogLogger) Debug(args ...interface{}) {
+	l.log(slog.LevelDebug, func() string { return fmt.Sprint(args...) })
+}
+
+func (l *slogLogger) Debugf(format string, args ...interface{}) {
+	l.log(slog.LevelDebug, func() string { return fmt.Sprintf(format, args...) })
+}
+
+func (l *slogLogger) Info(args ...interface{}) {
+	l.log(slog.LevelInfo, func() string { return fmt.Sprint(args...) })
+}
+
+func (l *slogLogger) Infof(format string, args ...interface{}) {
+	l.log(slog.LevelInfo, func() string { return fmt.Sprintf(format, args...) })
+}
+
+func (l *slogLogger) Warn(args ...interface{}) {
+	l.log(slog.LevelWarn, func() string { return fmt.Sprint(args...) })
+}
+
+func (l *slogLogger) Warnf(format string, args ...interface{}) {
+	l.log(slog.LevelWarn, func() string { return fmt.Sprintf(format, args...) })
+}
+
+func (l *slogLogger) Error(args ...interface{}) {
+	l.log(slog.LevelError, func() string { return fmt.Sprint(args...) })
+}
+
+func (l *slogLogger) Errorf(format string, args ...interface{}) {
+	l.log(slog.LevelError, func() string { return fmt.Sprintf(format, args...) })
+}
+
+func (l *slogLogger) WithFields(fields map[string]interface{}) Logger {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	attrs := make([]interface{}, 0, len(fields))
+	for _, key := range keys {
+		attrs = append(attrs, slog.Any(key, fields[key]))
+	}
+	return &slogLogger{logger: l.logger.With(attrs...), ctx: l.ctx}
+}
+
+func (l *slogLogger) WithError(err error) Logger {
+	return &slogLogger{logger: l.logger.With(slog.Any("error", err)), ctx: l.ctx}
+}
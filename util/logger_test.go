@@ -0,0 +1,117 @@
+/*
+ Copyright © 2025 Dell Inc. or its subsidiaries. All Rights Reserved.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+      http://www.apache.org/licenses/LICENSE-2.0
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlogLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewSlogLogger(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	logger.Debugf("hidden %d", 1)
+	logger.WithFields(map[string]interface{}{FieldMethod: "GET", FieldURI: "/api/types/pool/instances"}).WithError(errors.New("failure")).Warnf("request %s", "failed")
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 1)
+	record := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &record))
+	assert.Equal(t, "WARN", record["level"])
+	assert.Equal(t, "request failed", record["msg"])
+	assert.Equal(t, "GET", record[FieldMethod])
+	assert.Equal(t, "/api/types/pool/instances", record[FieldURI])
+	assert.Equal(t, "failure", record["error"])
+}
+
+// countingStringer counts how many times it is formatted
+type countingStringer struct {
+	calls int
+}
+
+func (s *countingStringer) String() string {
+	s.calls++
+	return "value"
+}
+
+// contextHandler records the request ID of the context of the records
+type contextHandler struct {
+	slog.Handler
+	requestIDs *[]string
+}
+
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	*h.requestIDs = append(*h.requestIDs, requestID)
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs), requestIDs: h.requestIDs}
+}
+
+func TestSlogLoggerLevelAndContext(t *testing.T) {
+	var requestIDs []string
+	handler := &contextHandler{Handler: slog.NewJSONHandler(&bytes.Buffer{}, &slog.HandlerOptions{Level: slog.LevelInfo}), requestIDs: &requestIDs}
+	logger := NewSlogLogger(handler)
+
+	// Disabled levels are not formatted
+	arg := &countingStringer{}
+	logger.Debugf("hidden %s", arg)
+	logger.Debug(arg)
+	assert.Zero(t, arg.calls)
+	logger.Infof("shown %s", arg)
+	assert.Equal(t, 1, arg.calls)
+
+	// The context of the caller reaches the handler
+	ContextLogger(WithRequestID(context.Background(), "req-1"), logger).WithError(errors.New("failure")).Warn("context")
+	assert.Equal(t, []string{"", "req-1"}, requestIDs)
+}
+
+func TestContextLogger(t *testing.T) {
+	clientBuf, ctxBuf := &bytes.Buffer{}, &bytes.Buffer{}
+	clientLogger := NewSlogLogger(slog.NewJSONHandler(clientBuf, nil))
+	ctxLogger := NewSlogLogger(slog.NewJSONHandler(ctxBuf, nil))
+
+	ContextLogger(WithRequestID(context.Background(), "req-1"), clientLogger).Info("client")
+	assert.Contains(t, clientBuf.String(), `"requestID":"req-1"`)
+
+	// The logger of the context takes precedence over the one of the client
+	ContextLogger(WithLogger(context.Background(), ctxLogger), clientLogger).Info("context")
+	assert.Contains(t, ctxBuf.String(), `"msg":"context"`)
+	assert.NotContains(t, clientBuf.String(), `"msg":"context"`)
+
+	// The client logger is preferred over the logrus entry of a CSI caller, whose fields it carries
+	entry := logrus.New().WithField("runid", "42")
+	entryCtx := context.WithValue(context.Background(), UnityLog, entry)
+	ContextLogger(entryCtx, clientLogger).Info("entry")
+	assert.Contains(t, clientBuf.String(), `"msg":"entry","runid":"42"`)
+
+	_, ok := ContextLogger(entryCtx, nil).(*logrusLogger)
+	assert.True(t, ok)
+
+	// Without any logger the shared logrus logger is used
+	_, ok = ContextLogger(context.Background(), nil).(*logrusLogger)
+	assert.True(t, ok)
+}
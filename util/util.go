@@ -37,11 +37,6 @@ var (
 	ErrorInvalidCharacters = errors.New("name contains invalid characters or name doesn't start with alphabetic. Allowed characters are 'a-zA-Z0-9_-'")
 )
 
-var (
-	Debug, _    = strconv.ParseBool(os.Getenv("GOUNITY_DEBUG"))
-	ShowHTTP, _ = strconv.ParseBool(os.Getenv("GOUNITY_SHOWHTTP"))
-)
-
 type contextKey string
 
 const UnityLog contextKey = "unitylog"
@@ -51,7 +46,7 @@ type UnityLogStruct struct {
 	unityLog string
 }
 
-// GetRunIDLogger function returns entry if exists
+// GetRunIDLogger function returns entry if exists. Use ContextLogger to also get the loggers set by WithLogger.
 func GetRunIDLogger(ctx context.Context) *logrus.Entry {
 	rlog := ctx.Value(UnityLog)
 	entry := &logrus.Entry{}
@@ -72,17 +67,19 @@ var (
 )
 
 // GetLogger is a singleton method which returns log object.
-// Type singletonLog initialized only once.
+// Type singletonLog initialized only once. It is the logger of the clients created without a Logger option.
 func GetLogger() *logrus.Logger {
 	once.Do(func() {
 		singletonLog = logrus.New()
-		fmt.Println("gounity logger initiated. This should be called only once.")
 
 		// Gounity users can make use of this environment variable to initialize log level. Default level will be Info
 		logLevel := os.Getenv("X_CSI_LOG_LEVEL")
 
-		// in addition, if Debug flag or ShowHTTP flag is set, then set log level to debug
-		if Debug || ShowHTTP {
+		// in addition, if GOUNITY_DEBUG or GOUNITY_SHOWHTTP is set, then set log level to debug. The clients
+		// are configured per client through api.ClientOptions.
+		debug, _ := strconv.ParseBool(os.Getenv("GOUNITY_DEBUG"))
+		showHTTP, _ := strconv.ParseBool(os.Getenv("GOUNITY_SHOWHTTP"))
+		if debug || showHTTP {
 			logLevel = "debug"
 		}
 
@@ -199,3 +196,37 @@ func GetSecuredCipherSuites() (suites []uint16) {
 	}
 	return suites
 }
+
+// NormalizeInitiatorID returns the canonical form of the given FC WWN or iSCSI name.
+// WWNs are upper cased and colon separated (with or without colons and 0x prefix on input),
+// iSCSI names (iqn., eui. and naa. formats) are case folded.
+func NormalizeInitiatorID(wwnOrIqn string) string {
+	id := strings.TrimSpace(wwnOrIqn)
+	lowerID := strings.ToLower(id)
+	if strings.HasPrefix(lowerID, "iqn.") || strings.HasPrefix(lowerID, "eui.") || strings.HasPrefix(lowerID, "naa.") {
+		return lowerID
+	}
+
+	hexID := strings.NewReplacer(":", "", "-", "").Replace(strings.TrimPrefix(lowerID, "0x"))
+	if (len(hexID) != 16 && len(hexID) != 32) || !isHexString(hexID) {
+		return id
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(hexID); i += 2 {
+		if i > 0 {
+			b.WriteString(":")
+		}
+		b.WriteString(strings.ToUpper(hexID[i : i+2]))
+	}
+	return b.String()
+}
+
+func isHexString(s string) bool {
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+	return true
+}
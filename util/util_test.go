@@ -31,6 +31,7 @@ func TestUtils(t *testing.T) {
 	validateResourceNameTest(t)
 	validateDurationTest(t)
 	getSecuredCipherSuitesTest(t)
+	normalizeInitiatorIDTest(t)
 }
 
 func getRunIDLoggerTest(t *testing.T) {
@@ -72,13 +73,11 @@ func getRunIDLoggerTest(t *testing.T) {
 	fmt.Println("Get RunId Logger Test Successful")
 }
 
-func getLoggerTest(_ *testing.T) {
+func getLoggerTest(t *testing.T) {
 	fmt.Println("Begin - Get Logger Test")
-	// debug flag needs to be true to hit a test condition, reset it after
-	formerDebug := Debug
-	Debug = true
+	// debug flag needs to be true to hit a test condition, it is reset after the test
+	t.Setenv("GOUNITY_DEBUG", "true")
 	_ = GetLogger()
-	Debug = formerDebug
 	fmt.Println("Get Logger Test Successful")
 }
 
@@ -241,3 +240,25 @@ func TestChangeLogLevel(t *testing.T) {
 		}
 	})
 }
+
+func normalizeInitiatorIDTest(t *testing.T) {
+	fmt.Println("Begin - Normalize Initiator ID Test")
+
+	tests := map[string]string{
+		"20:00:00:90:fa:53:4b:1a:10:00:00:90:fa:53:4b:1a": "20:00:00:90:FA:53:4B:1A:10:00:00:90:FA:53:4B:1A",
+		"20000090FA534B1A10000090FA534B1A":                "20:00:00:90:FA:53:4B:1A:10:00:00:90:FA:53:4B:1A",
+		"0x10000090fa534b1a":                              "10:00:00:90:FA:53:4B:1A",
+		"10-00-00-90-fa-53-4b-1a":                         "10:00:00:90:FA:53:4B:1A",
+		" iqn.1996-04.DE.SUSE:01:F8298E544DC ":            "iqn.1996-04.de.suse:01:f8298e544dc",
+		"EUI.02004567A425678D":                            "eui.02004567a425678d",
+		"valid_wwn1":                                      "valid_wwn1",
+		"10000090fa534b1z":                                "10000090fa534b1z",
+	}
+	for input, expected := range tests {
+		if actual := NormalizeInitiatorID(input); actual != expected {
+			t.Fatalf("NormalizeInitiatorID(%q) = %q, expected %q", input, actual, expected)
+		}
+	}
+
+	fmt.Println("Normalize Initiator ID Test Successful")
+}
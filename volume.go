@@ -16,7 +16,6 @@ import (
 
 	"github.com/dell/gounity/api"
 	"github.com/dell/gounity/types"
-	"github.com/dell/gounity/util"
 )
 
 // LicenseType is string
@@ -57,7 +56,7 @@ var MarkVolumeForDeletion = "csi-marked-vol-for-deletion"
 func (c *UnityClientImpl) CreateLun(ctx context.Context, name, poolID, description string, size uint64, fastVPTieringPolicy int,
 	hostIOLimitID string, isThinEnabled, isDataReductionEnabled bool,
 ) (*types.Volume, error) {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 
 	if name == "" {
 		return nil, errors.New("lun name should not be empty")
@@ -67,6 +66,12 @@ func (c *UnityClientImpl) CreateLun(ctx context.Context, name, poolID, descripti
 		return nil, fmt.Errorf("lun name %s should not exceed 63 characters", name)
 	}
 
+	if isDataReductionEnabled {
+		if err := c.Capabilities().Require(DataReductionCapability); err != nil {
+			return nil, err
+		}
+	}
+
 	pool, err := c.FindStoragePoolByID(ctx, poolID)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get PoolID (%s) Error:%v", poolID, err)
@@ -158,7 +163,7 @@ func (c *UnityClientImpl) FindVolumeByName(ctx context.Context, volName string)
 
 // FindVolumeByID - Find the volume by it's Id. If the volume is not found, an error will be returned.
 func (c *UnityClientImpl) FindVolumeByID(ctx context.Context, volID string) (*types.Volume, error) {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	if len(volID) == 0 {
 		return nil, errors.New("lun ID shouldn't be empty")
 	}
@@ -176,7 +181,7 @@ func (c *UnityClientImpl) FindVolumeByID(ctx context.Context, volID string) (*ty
 
 // ListVolumes - list volumes
 func (c *UnityClientImpl) ListVolumes(ctx context.Context, startToken int, maxEntries int) ([]types.Volume, int, error) {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	volumeResp := &types.ListVolumes{}
 	nextToken := startToken + 1
 	lunURI := fmt.Sprintf(api.UnityAPIInstanceTypeResourcesWithFields, api.LunAction, LunDisplayFields)
@@ -197,9 +202,25 @@ func (c *UnityClientImpl) ListVolumes(ctx context.Context, startToken int, maxEn
 	return volumeResp.Volumes, nextToken, err
 }
 
+// listAllVolumes lists the volumes of all the pages
+func (c *UnityClientImpl) listAllVolumes(ctx context.Context) ([]types.Volume, error) {
+	var volumes []types.Volume
+	for page := 1; ; page++ {
+		result := &types.ListVolumes{}
+		err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, pagedListURI(api.LunAction, nil, LunDisplayFields, page), nil, result)
+		if err != nil {
+			return nil, err
+		}
+		volumes = append(volumes, result.Volumes...)
+		if len(result.Volumes) == 0 || !hasNextPage(result.Links) {
+			return volumes, nil
+		}
+	}
+}
+
 // DeleteVolume - Delete Volume by its ID. If the Volume is not present on the array, an error will be returned.
 func (c *UnityClientImpl) DeleteVolume(ctx context.Context, volumeID string) error {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	if len(volumeID) == 0 {
 		return errors.New("Volume Id cannot be empty")
 	}
@@ -313,7 +334,7 @@ func (c *UnityClientImpl) UnexportVolume(ctx context.Context, volID string) erro
 
 // ExpandVolume - Expand volume to provided capacity
 func (c *UnityClientImpl) ExpandVolume(ctx context.Context, volumeID string, newSize uint64) error {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	vol, err := c.FindVolumeByID(ctx, volumeID)
 	if err != nil {
 		return fmt.Errorf("unable to find volume Id %s Error: %v", volumeID, err)
@@ -360,10 +381,9 @@ func (c *UnityClientImpl) CreteLunThinClone(ctx context.Context, name, snapID, v
 	return volumeResp, err
 }
 
-// isFeatureLicensed - Get License information
+// isFeatureLicensed - Get License information, served from the license cache
 func (c *UnityClientImpl) isFeatureLicensed(ctx context.Context, featureName LicenseType) (*types.LicenseInfo, error) {
-	licenseInfoResp := &types.LicenseInfo{}
-	err := c.executeWithRetryAuthenticate(ctx, http.MethodGet, fmt.Sprintf(api.UnityAPIGetResourceByNameWithFieldsURI, api.LicenseAction, featureName, LicenseInfoDisplayFields), nil, licenseInfoResp)
+	licenseInfoResp, err := c.GetLicense(ctx, featureName)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get license info for feature: %s", featureName)
 	}
@@ -372,7 +392,7 @@ func (c *UnityClientImpl) isFeatureLicensed(ctx context.Context, featureName Lic
 
 // CreateCloneFromVolume - Volume cloning
 func (c *UnityClientImpl) CreateCloneFromVolume(ctx context.Context, name, volID string) (*types.Volume, error) {
-	log := util.GetRunIDLogger(ctx)
+	log := c.log(ctx)
 	// Create snapshot for cloning
 	snapName := SnapForClone + strconv.FormatInt(time.Now().Unix(), 10)
 	snapResp, err := c.CreateSnapshot(ctx, volID, snapName, "", "")